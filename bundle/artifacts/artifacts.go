@@ -102,7 +102,7 @@ func (m *basicUpload) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return err
 	}
 
-	client, err := filer.NewWorkspaceFilesClient(b.WorkspaceClient(), uploadPath)
+	client, err := getUploadFiler(b, uploadPath)
 	if err != nil {
 		return err
 	}
@@ -115,7 +115,7 @@ func (m *basicUpload) Apply(ctx context.Context, b *bundle.Bundle) error {
 	return nil
 }
 
-func uploadArtifact(ctx context.Context, b *bundle.Bundle, a *config.Artifact, uploadPath string, client filer.Filer) error {
+func uploadArtifact(ctx context.Context, b *bundle.Bundle, a *config.Artifact, uploadPath config.PathLike, client filer.Filer) error {
 	filesToLibraries := libraries.MapFilesToTaskLibraries(ctx, b)
 
 	for i := range a.Files {
@@ -130,7 +130,7 @@ func uploadArtifact(ctx context.Context, b *bundle.Bundle, a *config.Artifact, u
 		}
 
 		log.Infof(ctx, "Upload succeeded")
-		f.RemotePath = path.Join(uploadPath, filepath.Base(f.Source))
+		f.RemotePath = uploadPath.Join(filepath.Base(f.Source)).String()
 
 		// Lookup all tasks that reference this file.
 		libs, ok := filesToLibraries[f.Source]
@@ -141,8 +141,11 @@ func uploadArtifact(ctx context.Context, b *bundle.Bundle, a *config.Artifact, u
 
 		// Update all tasks that reference this file.
 		for _, lib := range libs {
-			wsfsBase := "/Workspace"
-			remotePath := path.Join(wsfsBase, f.RemotePath)
+			remotePath := f.RemotePath
+			if uploadPath.IsWorkspace() {
+				wsfsBase := "/Workspace"
+				remotePath = path.Join(wsfsBase, f.RemotePath)
+			}
 			if lib.Whl != "" {
 				lib.Whl = remotePath
 				continue
@@ -151,6 +154,10 @@ func uploadArtifact(ctx context.Context, b *bundle.Bundle, a *config.Artifact, u
 				lib.Jar = remotePath
 				continue
 			}
+			if lib.Egg != "" {
+				lib.Egg = remotePath
+				continue
+			}
 		}
 	}
 
@@ -173,11 +180,30 @@ func uploadArtifactFile(ctx context.Context, file string, client filer.Filer) er
 	return nil
 }
 
-func getUploadBasePath(b *bundle.Bundle) (string, error) {
+func getUploadBasePath(b *bundle.Bundle) (config.PathLike, error) {
 	artifactPath := b.Config.Workspace.ArtifactPath
 	if artifactPath == "" {
 		return "", fmt.Errorf("remote artifact path not configured")
 	}
 
-	return path.Join(artifactPath, ".internal"), nil
+	return artifactPath.Join(".internal"), nil
+}
+
+// getUploadFiler returns a filer rooted at the specified upload path.
+// The filer implementation depends on the scheme of the path.
+func getUploadFiler(b *bundle.Bundle, uploadPath config.PathLike) (filer.Filer, error) {
+	switch uploadPath.Scheme() {
+	case config.PathSchemeWorkspace:
+		return filer.NewWorkspaceFilesClient(b.WorkspaceClient(), uploadPath.Path())
+	case config.PathSchemeDbfs:
+		return filer.NewDbfsClient(b.WorkspaceClient(), uploadPath.Path())
+	default:
+		return nil, errUnsupportedUploadPath(uploadPath)
+	}
+}
+
+// errUnsupportedUploadPath is returned for artifact paths that can be configured
+// but that artifacts cannot be uploaded to yet, such as paths on S3.
+func errUnsupportedUploadPath(uploadPath config.PathLike) error {
+	return fmt.Errorf("uploading artifacts to %s is unsupported; only workspace and DBFS paths are supported", uploadPath)
 }
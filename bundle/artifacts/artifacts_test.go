@@ -0,0 +1,52 @@
+package artifacts
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bundleWithArtifactPath(artifactPath config.PathLike) *bundle.Bundle {
+	return &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				ArtifactPath: artifactPath,
+			},
+		},
+	}
+}
+
+func TestGetUploadBasePath(t *testing.T) {
+	b := bundleWithArtifactPath("/Users/foo@bar.com/artifacts")
+	p, err := getUploadBasePath(b)
+	require.NoError(t, err)
+	assert.Equal(t, config.PathLike("/Users/foo@bar.com/artifacts/.internal"), p)
+	assert.True(t, p.IsWorkspace())
+}
+
+func TestGetUploadBasePathDbfs(t *testing.T) {
+	b := bundleWithArtifactPath("dbfs:/path/to/artifacts")
+	p, err := getUploadBasePath(b)
+	require.NoError(t, err)
+	assert.Equal(t, config.PathLike("dbfs:/path/to/artifacts/.internal"), p)
+	assert.Equal(t, config.PathSchemeDbfs, p.Scheme())
+}
+
+func TestGetUploadBasePathS3(t *testing.T) {
+	// S3 paths can be configured, but artifacts cannot be uploaded to them.
+	b := bundleWithArtifactPath("s3://bucket/artifacts")
+	p, err := getUploadBasePath(b)
+	require.NoError(t, err)
+	assert.Equal(t, config.PathLike("s3://bucket/artifacts/.internal"), p)
+
+	_, err = getUploadFiler(b, p)
+	assert.ErrorContains(t, err, "uploading artifacts to s3://bucket/artifacts/.internal is unsupported")
+}
+
+func TestGetUploadBasePathNotConfigured(t *testing.T) {
+	_, err := getUploadBasePath(bundleWithArtifactPath(""))
+	assert.ErrorContains(t, err, "remote artifact path not configured")
+}
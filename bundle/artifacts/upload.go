@@ -7,6 +7,7 @@ import (
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/databricks-sdk-go/service/files"
 	"github.com/databricks/databricks-sdk-go/service/workspace"
 )
 
@@ -87,12 +88,24 @@ func (m *cleanUp) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return err
 	}
 
-	b.WorkspaceClient().Workspace.Delete(ctx, workspace.Delete{
-		Path:      uploadPath,
-		Recursive: true,
-	})
-
-	err = b.WorkspaceClient().Workspace.MkdirsByPath(ctx, uploadPath)
+	switch uploadPath.Scheme() {
+	case config.PathSchemeWorkspace:
+		b.WorkspaceClient().Workspace.Delete(ctx, workspace.Delete{
+			Path:      uploadPath.Path(),
+			Recursive: true,
+		})
+
+		err = b.WorkspaceClient().Workspace.MkdirsByPath(ctx, uploadPath.Path())
+	case config.PathSchemeDbfs:
+		b.WorkspaceClient().Dbfs.Delete(ctx, files.Delete{
+			Path:      uploadPath.Path(),
+			Recursive: true,
+		})
+
+		err = b.WorkspaceClient().Dbfs.MkdirsByPath(ctx, uploadPath.Path())
+	default:
+		return errUnsupportedUploadPath(uploadPath)
+	}
 	if err != nil {
 		return fmt.Errorf("unable to create directory for %s: %w", uploadPath, err)
 	}
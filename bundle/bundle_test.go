@@ -77,7 +77,7 @@ func TestBundleMustLoadSuccess(t *testing.T) {
 	t.Setenv(env.RootVariable, "./tests/basic")
 	b, err := MustLoad(context.Background())
 	require.NoError(t, err)
-	assert.Equal(t, "tests/basic", filepath.ToSlash(b.Config.Path))
+	assert.Equal(t, absPath(t, "tests/basic"), b.Config.Path)
 }
 
 func TestBundleMustLoadFailureWithEnv(t *testing.T) {
@@ -96,7 +96,7 @@ func TestBundleTryLoadSuccess(t *testing.T) {
 	t.Setenv(env.RootVariable, "./tests/basic")
 	b, err := TryLoad(context.Background())
 	require.NoError(t, err)
-	assert.Equal(t, "tests/basic", filepath.ToSlash(b.Config.Path))
+	assert.Equal(t, absPath(t, "tests/basic"), b.Config.Path)
 }
 
 func TestBundleTryLoadFailureWithEnv(t *testing.T) {
@@ -111,3 +111,9 @@ func TestBundleTryLoadOkIfNotFound(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, b)
 }
+
+func absPath(t *testing.T, path string) string {
+	abs, err := filepath.Abs(filepath.FromSlash(path))
+	require.NoError(t, err)
+	return abs
+}
@@ -28,6 +28,14 @@ type Bundle struct {
 	// Force-override Git branch validation.
 	Force bool `json:"force,omitempty" bundle:"readonly"`
 
+	// Upload modified bundle files, including files whose content hash is unchanged
+	// since the last deployment.
+	ForceUpload bool `json:"force_upload,omitempty" bundle:"readonly"`
+
+	// Update all resources, including resources whose configuration is unchanged since the
+	// last deployment. Only applies to the direct deployment backend, which skips those.
+	ForceUpdate bool `json:"force_update,omitempty" bundle:"readonly"`
+
 	// Contains Git information like current commit, current branch and
 	// origin url. Automatically loaded by reading .git directory if not specified
 	Git Git `json:"git,omitempty"`
@@ -41,6 +49,20 @@ type Bundle struct {
 	// Overrides the compute used for jobs and other supported assets.
 	ComputeID string `json:"compute_id,omitempty"`
 
+	// Prefix for the names of resources deployed in development mode.
+	// Defaults to "[dev <short name of the current user>]".
+	NamePrefix string `json:"name_prefix,omitempty"`
+
+	// Only and Exclude select the resources to operate on, for example "job:my_job".
+	// They are set from the command line and are not meant to be configured.
+	Only    []string `json:"only,omitempty" bundle:"readonly"`
+	Exclude []string `json:"exclude,omitempty" bundle:"readonly"`
+
 	// Deployment section specifies deployment related configuration for bundle
 	Deployment Deployment `json:"deployment"`
 }
+
+// IsFiltered returns true if the bundle operates on a subset of its resources.
+func (b *Bundle) IsFiltered() bool {
+	return len(b.Only) > 0 || len(b.Exclude) > 0
+}
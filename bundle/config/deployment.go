@@ -1,10 +1,34 @@
 package config
 
+const (
+	// DeploymentBackendTerraform deploys resources with Terraform. This is the default.
+	DeploymentBackendTerraform = "terraform"
+
+	// DeploymentBackendDirect deploys resources through direct API calls.
+	DeploymentBackendDirect = "direct"
+)
+
+const (
+	// DeploymentSourceFiles uploads the bundle's files to the workspace. This is the default.
+	DeploymentSourceFiles = "files"
+
+	// DeploymentSourceRepo checks out the bundle's Git repository in a workspace Git folder.
+	DeploymentSourceRepo = "repo"
+)
+
 type Deployment struct {
 	// FailOnActiveRuns specifies whether to fail the deployment if there are
 	// running jobs or pipelines in the workspace. Defaults to false.
 	FailOnActiveRuns bool `json:"fail_on_active_runs,omitempty"`
 
+	// Backend selects how resources are deployed.
+	// Supported values are "terraform" (the default) and "direct".
+	Backend string `json:"backend,omitempty"`
+
+	// Source selects how the bundle's files are made available in the workspace.
+	// Supported values are "files" (the default) and "repo".
+	Source string `json:"source,omitempty"`
+
 	// Lock configures locking behavior on deployment.
 	Lock Lock `json:"lock" bundle:"readonly"`
 }
@@ -0,0 +1,130 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/convert"
+)
+
+type ChangeType string
+
+const (
+	ChangeAdded    ChangeType = "added"
+	ChangeRemoved  ChangeType = "removed"
+	ChangeModified ChangeType = "modified"
+)
+
+// FieldChange describes a single difference between two configurations.
+type FieldChange struct {
+	// Type is the type of change.
+	Type ChangeType
+
+	// Path is the configuration path of the value that changed.
+	Path dyn.Path
+
+	// Before is the value in the first configuration.
+	// It is [dyn.NilValue] if the value was added.
+	Before dyn.Value
+
+	// After is the value in the second configuration.
+	// It is [dyn.NilValue] if the value was removed.
+	After dyn.Value
+}
+
+func (c FieldChange) String() string {
+	switch c.Type {
+	case ChangeAdded:
+		return fmt.Sprintf("+ %s", c.Path)
+	case ChangeRemoved:
+		return fmt.Sprintf("- %s", c.Path)
+	default:
+		return fmt.Sprintf("~ %s: %v -> %v", c.Path, c.Before.AsAny(), c.After.AsAny())
+	}
+}
+
+// Diff returns the changes required to go from configuration a to configuration b.
+//
+// Maps are compared key by key and sequences are compared element by element.
+// Values that exist in only one of the configurations are reported once at the
+// path where they were added or removed (e.g. a new job is reported as a single
+// addition at `resources.jobs.<key>`). Scalar values that differ are reported as modified.
+//
+// Only the typed configuration is compared, so the location of a value is not
+// taken into account. Changes are sorted by their path.
+func Diff(a, b Root) ([]FieldChange, error) {
+	av, err := convert.FromTyped(a, dyn.NilValue)
+	if err != nil {
+		return nil, err
+	}
+
+	bv, err := convert.FromTyped(b, dyn.NilValue)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []FieldChange
+	diffValues(dyn.EmptyPath, av, bv, &changes)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path.String() < changes[j].Path.String()
+	})
+
+	return changes, nil
+}
+
+func diffValues(p dyn.Path, a, b dyn.Value, out *[]FieldChange) {
+	ak := a.Kind()
+	bk := b.Kind()
+
+	switch {
+	case ak == dyn.KindNil && bk == dyn.KindNil:
+		return
+	case ak == dyn.KindNil:
+		*out = append(*out, FieldChange{Type: ChangeAdded, Path: slices.Clone(p), Before: dyn.NilValue, After: b})
+		return
+	case bk == dyn.KindNil:
+		*out = append(*out, FieldChange{Type: ChangeRemoved, Path: slices.Clone(p), Before: a, After: dyn.NilValue})
+		return
+	case ak != bk:
+		*out = append(*out, FieldChange{Type: ChangeModified, Path: slices.Clone(p), Before: a, After: b})
+		return
+	}
+
+	switch ak {
+	case dyn.KindMap:
+		am := a.MustMap()
+		bm := b.MustMap()
+		for k, av := range am {
+			bv, ok := bm[k]
+			if !ok {
+				bv = dyn.NilValue
+			}
+			diffValues(p.Append(dyn.Key(k)), av, bv, out)
+		}
+		for k, bv := range bm {
+			if _, ok := am[k]; !ok {
+				diffValues(p.Append(dyn.Key(k)), dyn.NilValue, bv, out)
+			}
+		}
+	case dyn.KindSequence:
+		as := a.MustSequence()
+		bs := b.MustSequence()
+		for i := 0; i < max(len(as), len(bs)); i++ {
+			av, bv := dyn.NilValue, dyn.NilValue
+			if i < len(as) {
+				av = as[i]
+			}
+			if i < len(bs) {
+				bv = bs[i]
+			}
+			diffValues(p.Append(dyn.Index(i)), av, bv, out)
+		}
+	default:
+		if a.AsAny() != b.AsAny() {
+			*out = append(*out, FieldChange{Type: ChangeModified, Path: slices.Clone(p), Before: a, After: b})
+		}
+	}
+}
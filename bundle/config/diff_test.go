@@ -0,0 +1,115 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func changeStrings(changes []FieldChange) []string {
+	var out []string
+	for _, c := range changes {
+		out = append(out, c.String())
+	}
+	return out
+}
+
+func TestDiffIdentical(t *testing.T) {
+	root, err := Load("../tests/basic/databricks.yml")
+	require.NoError(t, err)
+
+	changes, err := Diff(*root, *root)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDiff(t *testing.T) {
+	a := Root{
+		Bundle: Bundle{
+			Name: "foo",
+		},
+		Resources: Resources{
+			Jobs: map[string]*resources.Job{
+				"job1": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job 1",
+						Tasks: []jobs.Task{
+							{TaskKey: "a"},
+							{TaskKey: "b"},
+						},
+					},
+				},
+				"job2": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job 2",
+					},
+				},
+			},
+		},
+	}
+
+	b := Root{
+		Bundle: Bundle{
+			Name: "bar",
+		},
+		Resources: Resources{
+			Jobs: map[string]*resources.Job{
+				"job1": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job 1",
+						Tasks: []jobs.Task{
+							{TaskKey: "a"},
+						},
+						MaxConcurrentRuns: 2,
+					},
+				},
+				"job3": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job 3",
+					},
+				},
+			},
+		},
+	}
+
+	changes, err := Diff(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"~ bundle.name: foo -> bar",
+		"+ resources.jobs.job1.max_concurrent_runs",
+		"- resources.jobs.job1.tasks[1]",
+		"- resources.jobs.job2",
+		"+ resources.jobs.job3",
+	}, changeStrings(changes))
+
+	assert.Equal(t, ChangeAdded, changes[4].Type)
+	assert.Equal(t, "job 3", changes[4].After.Get("name").MustString())
+}
+
+func TestDiffIsDeterministic(t *testing.T) {
+	a := Root{}
+	b := Root{
+		Workspace: Workspace{
+			Host:     "https://example.com",
+			RootPath: "/root",
+			FilePath: "/root/files",
+		},
+	}
+
+	first, err := Diff(a, b)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		changes, err := Diff(a, b)
+		require.NoError(t, err)
+		assert.Equal(t, changeStrings(first), changeStrings(changes))
+	}
+
+	assert.Equal(t, []string{
+		"+ workspace.file_path",
+		"+ workspace.host",
+		"+ workspace.root_path",
+	}, changeStrings(first))
+}
@@ -0,0 +1,15 @@
+package config
+
+// EnvVariable declares an environment variable that is referenced
+// in the configuration as `${env.NAME}`.
+type EnvVariable struct {
+	// Documentation for this environment variable.
+	Description string `json:"description,omitempty"`
+
+	// Value to use if the environment variable is not set.
+	Default *string `json:"default,omitempty"`
+
+	// If set, the environment variable must be set even if it is not referenced.
+	// A required variable cannot have a default.
+	Required bool `json:"required,omitempty"`
+}
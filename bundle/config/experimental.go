@@ -10,6 +10,11 @@ type Experimental struct {
 	// In this case the configured wheel task will be deployed as a notebook task which install defined wheel in runtime and executes it.
 	// For more details see https://github.com/databricks/cli/pull/797 and https://github.com/databricks/cli/pull/635
 	PythonWheelWrapper bool `json:"python_wheel_wrapper,omitempty"`
+
+	// If set, shell-style references to environment variables (`$FOO` or `${FOO}`)
+	// are expanded in all string values of the configuration when it is loaded.
+	// Other references (e.g. `${var.foo}`) and shell commands are not affected.
+	ExpandEnvironmentVariables bool `json:"expand_environment_variables,omitempty"`
 }
 
 type Command string
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/convert"
+	"github.com/databricks/cli/libs/dyn/yamlsaver"
+)
+
+// ExportJSON returns the configuration as indented JSON.
+//
+// It serializes the typed configuration, so the output reflects the effect of
+// any mutators that have been applied (e.g. variable resolution). Map keys are
+// sorted and empty values are omitted, such that the output is stable and can be
+// used to snapshot a configuration or to feed it to other tools.
+func (r *Root) ExportJSON() ([]byte, error) {
+	v, err := r.exportValue()
+	if err != nil {
+		return nil, err
+	}
+
+	var out any = map[string]any{}
+	if v.Kind() != dyn.KindNil {
+		out = v.AsAny()
+	}
+
+	return json.MarshalIndent(out, "", "  ")
+}
+
+// WriteYAML writes the configuration as YAML to the specified writer.
+//
+// Like [Root.ExportJSON], it serializes the typed configuration with sorted
+// keys and without empty values. Multiline strings (e.g. SQL queries) are
+// written using the literal block style.
+func (r *Root) WriteYAML(w io.Writer) error {
+	v, err := r.exportValue()
+	if err != nil {
+		return err
+	}
+
+	if v.Kind() == dyn.KindNil {
+		v = dyn.V(map[string]dyn.Value{})
+	}
+
+	return yamlsaver.NewSaver().Encode(w, v)
+}
+
+// exportValue returns the dynamic representation of the typed configuration
+// with empty values removed.
+func (r *Root) exportValue() (dyn.Value, error) {
+	v, err := convert.FromTyped(r, dyn.NilValue)
+	if err != nil {
+		return dyn.InvalidValue, err
+	}
+
+	return r.redactSecrets(pruneEmpty(v))
+}
+
+// redactSecrets replaces the values of secrets in the fields they were resolved into.
+// Other fields are left as is, even if they happen to contain the same value.
+func (r *Root) redactSecrets(v dyn.Value) (dyn.Value, error) {
+	for _, secret := range r.secrets {
+		fv, err := dyn.GetByPath(v, secret.path)
+		if err != nil {
+			// The field was removed or is empty.
+			continue
+		}
+		s, ok := fv.AsString()
+		if !ok {
+			continue
+		}
+		s = strings.ReplaceAll(s, secret.value, redactedSecret)
+		v, err = dyn.SetByPath(v, secret.path, dyn.NewValue(s, fv.Location()))
+		if err != nil {
+			return dyn.InvalidValue, err
+		}
+	}
+	return v, nil
+}
+
+// pruneEmpty recursively removes nil values, empty maps, and empty sequences
+// from maps. Elements of sequences are retained to preserve their position.
+func pruneEmpty(v dyn.Value) dyn.Value {
+	switch v.Kind() {
+	case dyn.KindMap:
+		out := make(map[string]dyn.Value)
+		for k, ev := range v.MustMap() {
+			ev = pruneEmpty(ev)
+			if isEmpty(ev) {
+				continue
+			}
+			out[k] = ev
+		}
+		if len(out) == 0 {
+			return dyn.NilValue
+		}
+		return dyn.NewValue(out, v.Location())
+	case dyn.KindSequence:
+		s := v.MustSequence()
+		out := make([]dyn.Value, len(s))
+		for i, ev := range s {
+			out[i] = pruneEmpty(ev)
+		}
+		return dyn.NewValue(out, v.Location())
+	default:
+		return v
+	}
+}
+
+func isEmpty(v dyn.Value) bool {
+	switch v.Kind() {
+	case dyn.KindNil:
+		return true
+	case dyn.KindSequence:
+		return len(v.MustSequence()) == 0
+	default:
+		return false
+	}
+}
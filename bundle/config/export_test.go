@@ -0,0 +1,165 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportJSONEmpty(t *testing.T) {
+	buf, err := (&Root{}).ExportJSON()
+	require.NoError(t, err)
+	assert.Equal(t, "{}", string(buf))
+}
+
+func TestExportJSON(t *testing.T) {
+	r := &Root{
+		Bundle: Bundle{
+			Name: "foo",
+		},
+		Resources: Resources{
+			Jobs: map[string]*resources.Job{
+				"job2": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job 2",
+					},
+				},
+				"job1": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job 1",
+						Tasks: []jobs.Task{
+							{TaskKey: "a"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	buf, err := r.ExportJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "bundle": {
+    "name": "foo"
+  },
+  "resources": {
+    "jobs": {
+      "job1": {
+        "name": "job 1",
+        "tasks": [
+          {
+            "task_key": "a"
+          }
+        ]
+      },
+      "job2": {
+        "name": "job 2"
+      }
+    }
+  }
+}`, string(buf))
+
+	// Output is stable across invocations.
+	for i := 0; i < 10; i++ {
+		again, err := r.ExportJSON()
+		require.NoError(t, err)
+		assert.Equal(t, string(buf), string(again))
+	}
+}
+
+func TestExportJSONRoundTrip(t *testing.T) {
+	root, err := Load("../tests/basic/databricks.yml")
+	require.NoError(t, err)
+
+	buf, err := root.ExportJSON()
+	require.NoError(t, err)
+
+	// Exported JSON can be loaded back into a configuration that exports identically.
+	var other Root
+	err = json.Unmarshal(buf, &other)
+	require.NoError(t, err)
+
+	again, err := other.ExportJSON()
+	require.NoError(t, err)
+	assert.Equal(t, string(buf), string(again))
+	assert.Equal(t, "basic", other.Bundle.Name)
+}
+
+func TestWriteYAML(t *testing.T) {
+	r := &Root{
+		Bundle: Bundle{
+			Name: "foo",
+		},
+		Resources: Resources{
+			Jobs: map[string]*resources.Job{
+				"job": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job",
+						Tasks: []jobs.Task{
+							{
+								TaskKey: "query",
+								SqlTask: &jobs.SqlTask{
+									WarehouseId: "abc",
+									Query: &jobs.SqlTaskQuery{
+										QueryId: "SELECT *\nFROM table\n",
+									},
+								},
+							},
+						},
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := r.WriteYAML(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, `bundle:
+  name: foo
+resources:
+  jobs:
+    job:
+      max_concurrent_runs: 1
+      name: job
+      tasks:
+        - sql_task:
+            query:
+              query_id: |
+                SELECT *
+                FROM table
+            warehouse_id: abc
+          task_key: query
+`, buf.String())
+}
+
+func TestWriteYAMLRoundTrip(t *testing.T) {
+	root, err := Load("../tests/job_and_pipeline/databricks.yml")
+	require.NoError(t, err)
+	err = root.MergeTargetOverrides("production")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "databricks.yml")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	err = root.WriteYAML(f)
+	f.Close()
+	require.NoError(t, err)
+
+	// Writing the configuration back to YAML and loading it yields the same configuration.
+	other, err := Load(path)
+	require.NoError(t, err)
+
+	changes, err := Diff(*root, *other)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,213 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/convert"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+)
+
+// Graph is a directed graph of dependencies between the resources
+// and variables in a bundle configuration.
+//
+// Nodes are identified by their configuration path, for example
+// `resources.jobs.my_job`, `resources.jobs.my_job.job_clusters.main`, or `variables.my_var`.
+// An edge from node a to node b means that a depends on b.
+type Graph struct {
+	nodes []string
+	edges map[string][]string
+}
+
+func newGraph() *Graph {
+	return &Graph{
+		edges: make(map[string][]string),
+	}
+}
+
+func (g *Graph) addNode(node string) {
+	if !slices.Contains(g.nodes, node) {
+		g.nodes = append(g.nodes, node)
+	}
+}
+
+func (g *Graph) addEdge(from, to string) {
+	if from == to || slices.Contains(g.edges[from], to) {
+		return
+	}
+	g.edges[from] = append(g.edges[from], to)
+}
+
+// Nodes returns the sorted list of nodes in the graph.
+func (g *Graph) Nodes() []string {
+	out := slices.Clone(g.nodes)
+	sort.Strings(out)
+	return out
+}
+
+// Dependencies returns the sorted list of nodes that the specified node depends on.
+func (g *Graph) Dependencies(node string) []string {
+	out := slices.Clone(g.edges[node])
+	sort.Strings(out)
+	return out
+}
+
+// TopologicalOrder returns the nodes in the graph such that every node comes
+// after the nodes it depends on. Nodes that don't depend on each other are
+// ordered by name, such that the order is stable.
+// It returns an error if the graph contains a cycle.
+func (g *Graph) TopologicalOrder() ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int)
+	var order []string
+	var stack []string
+
+	var visit func(node string) error
+	visit = func(node string) error {
+		switch state[node] {
+		case visited:
+			return nil
+		case visiting:
+			i := slices.Index(stack, node)
+			cycle := append(slices.Clone(stack[i:]), node)
+			return fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+		}
+
+		state[node] = visiting
+		stack = append(stack, node)
+		for _, dep := range g.Dependencies(node) {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[node] = visited
+		order = append(order, node)
+		return nil
+	}
+
+	for _, node := range g.Nodes() {
+		if err := visit(node); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// DependencyGraph returns the graph of dependencies between the resources and
+// variables in this configuration. Dependencies are derived from:
+//   - References to other resources, e.g. `${resources.pipelines.my_pipeline.id}`.
+//   - References to variables, e.g. `${var.my_var}`, including references between variables.
+//   - The `job_cluster_key` of job tasks, which refers to a cluster defined in the same job.
+//
+// It returns an error if a task refers to an undefined job cluster, or if the graph contains a cycle.
+func (r *Root) DependencyGraph() (*Graph, error) {
+	v := r.value
+	if !v.IsValid() {
+		var err error
+		v, err = convert.FromTyped(r, dyn.NilValue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	g := newGraph()
+
+	// Add nodes for all variables and resources first, such that
+	// references to them can be checked while adding edges.
+	for name := range r.Variables {
+		g.addNode("variables." + name)
+	}
+	resources := dyn.NewPattern(dyn.Key("resources"), dyn.AnyKey(), dyn.AnyKey())
+	_, err := dyn.MapByPattern(v, resources, func(p dyn.Path, rv dyn.Value) (dyn.Value, error) {
+		g.addNode(p.String())
+		return rv, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Add edges for references.
+	_, err = dyn.MapByPattern(v, resources, func(p dyn.Path, rv dyn.Value) (dyn.Value, error) {
+		addReferenceEdges(g, p.String(), rv)
+		return rv, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for name := range r.Variables {
+		addReferenceEdges(g, "variables."+name, v.Get("variables").Get(name))
+	}
+
+	// Add edges for job clusters.
+	for key, job := range r.Resources.Jobs {
+		if job.JobSettings == nil {
+			continue
+		}
+
+		node := "resources.jobs." + key
+		for _, jc := range job.JobClusters {
+			g.addNode(node + ".job_clusters." + jc.JobClusterKey)
+		}
+		for _, task := range job.Tasks {
+			if task.JobClusterKey == "" {
+				continue
+			}
+			cluster := node + ".job_clusters." + task.JobClusterKey
+			if !slices.Contains(g.nodes, cluster) {
+				return nil, fmt.Errorf("task %s in %s refers to undefined job cluster %s", task.TaskKey, node, task.JobClusterKey)
+			}
+			g.addEdge(node, cluster)
+		}
+	}
+
+	// Make sure the graph is acyclic.
+	_, err = g.TopologicalOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// addReferenceEdges adds edges from the specified node to the resources and
+// variables referenced by string values in the specified value.
+func addReferenceEdges(g *Graph, from string, v dyn.Value) {
+	_, _ = dyn.Walk(v, func(_ dyn.Path, v dyn.Value) (dyn.Value, error) {
+		s, ok := v.AsString()
+		if !ok {
+			return v, nil
+		}
+
+		for _, ref := range dynvar.References(s) {
+			var to string
+			parts := strings.Split(ref, ".")
+			switch {
+			case len(parts) >= 2 && parts[0] == "var":
+				to = "variables." + parts[1]
+			case len(parts) >= 3 && parts[0] == "variables":
+				to = "variables." + parts[1]
+			case len(parts) >= 3 && parts[0] == "resources":
+				to = strings.Join(parts[:3], ".")
+			default:
+				continue
+			}
+
+			// References to undefined nodes are reported when resolving references.
+			if slices.Contains(g.nodes, to) {
+				g.addEdge(from, to)
+			}
+		}
+
+		return v, nil
+	})
+}
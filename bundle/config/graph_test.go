@@ -0,0 +1,98 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadRootFromYAML(t *testing.T, contents string) *Root {
+	dir := t.TempDir()
+	writeBundleConfig(t, dir, contents)
+	root, err := Load(filepath.Join(dir, "databricks.yml"))
+	require.NoError(t, err)
+	return root
+}
+
+func TestDependencyGraph(t *testing.T) {
+	root := loadRootFromYAML(t, `
+variables:
+  catalog:
+    default: main
+  schema:
+    default: ${var.catalog}_schema
+
+resources:
+  pipelines:
+    ingest:
+      name: ingest
+      catalog: ${var.catalog}
+      target: ${var.schema}
+
+  jobs:
+    refresh:
+      name: refresh
+      job_clusters:
+        - job_cluster_key: main
+      tasks:
+        - task_key: pipeline
+          pipeline_task:
+            pipeline_id: ${resources.pipelines.ingest.id}
+        - task_key: notebook
+          job_cluster_key: main
+          notebook_task:
+            notebook_path: ./notebook.py
+`)
+
+	g, err := root.DependencyGraph()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"resources.jobs.refresh.job_clusters.main",
+		"resources.pipelines.ingest",
+	}, g.Dependencies("resources.jobs.refresh"))
+	assert.Equal(t, []string{
+		"variables.catalog",
+		"variables.schema",
+	}, g.Dependencies("resources.pipelines.ingest"))
+	assert.Equal(t, []string{"variables.catalog"}, g.Dependencies("variables.schema"))
+
+	order, err := g.TopologicalOrder()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"resources.jobs.refresh.job_clusters.main",
+		"variables.catalog",
+		"variables.schema",
+		"resources.pipelines.ingest",
+		"resources.jobs.refresh",
+	}, order)
+}
+
+func TestDependencyGraphCycle(t *testing.T) {
+	root := loadRootFromYAML(t, `
+variables:
+  a:
+    default: ${var.b}
+  b:
+    default: ${var.a}
+`)
+
+	_, err := root.DependencyGraph()
+	assert.ErrorContains(t, err, "dependency cycle detected: variables.a -> variables.b -> variables.a")
+}
+
+func TestDependencyGraphUndefinedJobCluster(t *testing.T) {
+	root := loadRootFromYAML(t, `
+resources:
+  jobs:
+    job:
+      tasks:
+        - task_key: task
+          job_cluster_key: missing
+`)
+
+	_, err := root.DependencyGraph()
+	assert.ErrorContains(t, err, "task task in resources.jobs.job refers to undefined job cluster missing")
+}
@@ -0,0 +1,40 @@
+package lint
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+type jobMaxConcurrentRuns struct{}
+
+// JobMaxConcurrentRuns reports jobs that don't configure max_concurrent_runs.
+// It is easy to overlook that such jobs default to a single concurrent run.
+func JobMaxConcurrentRuns() validate.Validator {
+	return &jobMaxConcurrentRuns{}
+}
+
+func (r *jobMaxConcurrentRuns) Name() string {
+	return "lint:job_max_concurrent_runs"
+}
+
+func (r *jobMaxConcurrentRuns) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for key, job := range b.Config.Resources.Jobs {
+		if job.JobSettings == nil || job.MaxConcurrentRuns != 0 {
+			continue
+		}
+
+		diags = diags.Append(diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "max_concurrent_runs is not set; the job defaults to a single concurrent run",
+			Path:     dyn.NewPath(dyn.Key("resources"), dyn.Key("jobs"), dyn.Key(key)),
+		})
+	}
+
+	return diags
+}
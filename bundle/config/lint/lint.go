@@ -0,0 +1,18 @@
+// Package lint implements rules that flag configuration that is valid,
+// but likely to cause problems once deployed. Rules implement the
+// [validate.Validator] interface and report their findings as warnings.
+package lint
+
+import (
+	"github.com/databricks/cli/bundle/config/validate"
+)
+
+// Rules returns the default set of lint rules.
+func Rules() []validate.Validator {
+	return []validate.Validator{
+		JobMaxConcurrentRuns(),
+		PipelineTarget(),
+		SparkVersions(),
+		UserNotebookPaths(),
+	}
+}
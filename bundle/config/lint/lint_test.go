@@ -0,0 +1,92 @@
+package lint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadBundle(t *testing.T, contents string) *bundle.Bundle {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "databricks.yml")
+	err := os.WriteFile(path, []byte(contents), 0644)
+	require.NoError(t, err)
+
+	root, err := config.Load(path)
+	require.NoError(t, err)
+	return &bundle.Bundle{Config: *root}
+}
+
+func summaries(diags diag.Diagnostics) []string {
+	var out []string
+	for _, d := range diags {
+		out = append(out, d.Path.String()+": "+d.Summary)
+	}
+	return out
+}
+
+func TestLintRules(t *testing.T) {
+	b := loadBundle(t, `
+resources:
+  jobs:
+    configured:
+      name: configured
+      max_concurrent_runs: 1
+      job_clusters:
+        - job_cluster_key: main
+          new_cluster:
+            spark_version: 14.3.x-scala2.12
+      tasks:
+        - task_key: notebook
+          job_cluster_key: main
+          notebook_task:
+            notebook_path: ./notebook.py
+    legacy:
+      name: legacy
+      tasks:
+        - task_key: notebook
+          new_cluster:
+            spark_version: 12.2.x-scala2.12
+          notebook_task:
+            notebook_path: /Users/someone@example.com/notebook
+
+  pipelines:
+    published:
+      target: main
+    unpublished:
+      libraries:
+        - notebook:
+            path: /Workspace/Users/someone@example.com/dlt
+`)
+
+	diags := validate.Validate(context.Background(), b, Rules()...)
+	assert.Equal(t, []string{
+		`resources.jobs.legacy: max_concurrent_runs is not set; the job defaults to a single concurrent run`,
+		`resources.jobs.legacy.tasks[0].new_cluster.spark_version: spark_version "12.2.x-scala2.12" is past its end of support; use 13.3 or newer`,
+		`resources.jobs.legacy.tasks[0].notebook_task.notebook_path: notebook path "/Users/someone@example.com/notebook" refers to the home folder of a user; use a path relative to the bundle instead`,
+		`resources.pipelines.unpublished: target is not set; tables of this pipeline are not published`,
+		`resources.pipelines.unpublished.libraries[0].notebook.path: notebook path "/Workspace/Users/someone@example.com/dlt" refers to the home folder of a user; use a path relative to the bundle instead`,
+	}, summaries(diags))
+
+	for _, d := range diags {
+		assert.Equal(t, diag.Warning, d.Severity)
+		assert.NotEmpty(t, d.Location.File)
+	}
+}
+
+func TestIsDeprecatedSparkVersion(t *testing.T) {
+	assert.True(t, isDeprecatedSparkVersion("10.4.x-scala2.12"))
+	assert.True(t, isDeprecatedSparkVersion("13.2.x-scala2.12"))
+	assert.False(t, isDeprecatedSparkVersion("13.3.x-scala2.12"))
+	assert.False(t, isDeprecatedSparkVersion("15.4.x-photon-scala2.12"))
+	assert.False(t, isDeprecatedSparkVersion("${var.spark_version}"))
+	assert.False(t, isDeprecatedSparkVersion(""))
+}
@@ -0,0 +1,40 @@
+package lint
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+type pipelineTarget struct{}
+
+// PipelineTarget reports pipelines without a target schema.
+// The tables of such pipelines are not published to the metastore.
+func PipelineTarget() validate.Validator {
+	return &pipelineTarget{}
+}
+
+func (r *pipelineTarget) Name() string {
+	return "lint:pipeline_target"
+}
+
+func (r *pipelineTarget) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for key, pipeline := range b.Config.Resources.Pipelines {
+		if pipeline.PipelineSpec == nil || pipeline.Target != "" {
+			continue
+		}
+
+		diags = diags.Append(diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "target is not set; tables of this pipeline are not published",
+			Path:     dyn.NewPath(dyn.Key("resources"), dyn.Key("pipelines"), dyn.Key(key)),
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,89 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+)
+
+// Runtime versions older than this are past their end of support.
+var minimumSparkVersion = [2]int{13, 3}
+
+var sparkVersionRegex = regexp.MustCompile(`^(\d+)\.(\d+)\.`)
+
+type sparkVersions struct{}
+
+// SparkVersions reports job clusters that use a Databricks Runtime
+// version that is past its end of support.
+func SparkVersions() validate.Validator {
+	return &sparkVersions{}
+}
+
+func (r *sparkVersions) Name() string {
+	return "lint:spark_versions"
+}
+
+// isDeprecatedSparkVersion returns true if the version is recognized
+// and older than the minimum supported version.
+func isDeprecatedSparkVersion(version string) bool {
+	m := sparkVersionRegex.FindStringSubmatch(version)
+	if m == nil {
+		return false
+	}
+
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	if major != minimumSparkVersion[0] {
+		return major < minimumSparkVersion[0]
+	}
+	return minor < minimumSparkVersion[1]
+}
+
+func checkSparkVersion(cluster *compute.ClusterSpec, p dyn.Path) diag.Diagnostics {
+	if cluster == nil || !isDeprecatedSparkVersion(cluster.SparkVersion) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary: fmt.Sprintf(
+				"spark_version %q is past its end of support; use %d.%d or newer",
+				cluster.SparkVersion,
+				minimumSparkVersion[0],
+				minimumSparkVersion[1],
+			),
+			Path: slices.Clone(p).Append(dyn.Key("spark_version")),
+		},
+	}
+}
+
+func (r *sparkVersions) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for key, job := range b.Config.Resources.Jobs {
+		if job.JobSettings == nil {
+			continue
+		}
+
+		p := dyn.NewPath(dyn.Key("resources"), dyn.Key("jobs"), dyn.Key(key))
+		for i := range job.JobClusters {
+			cluster := job.JobClusters[i].NewCluster
+			diags = diags.Extend(checkSparkVersion(cluster, p.Append(dyn.Key("job_clusters"), dyn.Index(i), dyn.Key("new_cluster"))))
+		}
+		for i := range job.Tasks {
+			cluster := job.Tasks[i].NewCluster
+			diags = diags.Extend(checkSparkVersion(cluster, p.Append(dyn.Key("tasks"), dyn.Index(i), dyn.Key("new_cluster"))))
+		}
+	}
+
+	return diags
+}
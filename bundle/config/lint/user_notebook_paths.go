@@ -0,0 +1,94 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+type userNotebookPaths struct{}
+
+// UserNotebookPaths reports notebook paths that point into the home folder of a user.
+// Such paths break when the bundle is deployed by someone else or by a service principal.
+func UserNotebookPaths() validate.Validator {
+	return &userNotebookPaths{}
+}
+
+func (r *userNotebookPaths) Name() string {
+	return "lint:user_notebook_paths"
+}
+
+func isUserPath(p string) bool {
+	return strings.HasPrefix(p, "/Users/") || strings.HasPrefix(p, "/Workspace/Users/")
+}
+
+func checkUserPath(notebookPath string, p dyn.Path) diag.Diagnostics {
+	if !isUserPath(notebookPath) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("notebook path %q refers to the home folder of a user; use a path relative to the bundle instead", notebookPath),
+			Path:     p,
+		},
+	}
+}
+
+func (r *userNotebookPaths) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for key, job := range b.Config.Resources.Jobs {
+		if job.JobSettings == nil {
+			continue
+		}
+
+		for i, task := range job.Tasks {
+			if task.NotebookTask == nil {
+				continue
+			}
+
+			p := dyn.NewPath(
+				dyn.Key("resources"),
+				dyn.Key("jobs"),
+				dyn.Key(key),
+				dyn.Key("tasks"),
+				dyn.Index(i),
+				dyn.Key("notebook_task"),
+				dyn.Key("notebook_path"),
+			)
+			diags = diags.Extend(checkUserPath(task.NotebookTask.NotebookPath, p))
+		}
+	}
+
+	for key, pipeline := range b.Config.Resources.Pipelines {
+		if pipeline.PipelineSpec == nil {
+			continue
+		}
+
+		for i, library := range pipeline.Libraries {
+			if library.Notebook == nil {
+				continue
+			}
+
+			p := dyn.NewPath(
+				dyn.Key("resources"),
+				dyn.Key("pipelines"),
+				dyn.Key(key),
+				dyn.Key("libraries"),
+				dyn.Index(i),
+				dyn.Key("notebook"),
+				dyn.Key("path"),
+			)
+			diags = diags.Extend(checkUserPath(library.Notebook.Path, p))
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,60 @@
+package mutator
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+)
+
+type applyWorkspaceDefaults struct{}
+
+// ApplyWorkspaceDefaults fills in the settings defined in `workspace.defaults`
+// on all new job clusters that don't specify them.
+//
+// The node type is not applied to clusters that use an instance pool,
+// because the pool determines the node type.
+func ApplyWorkspaceDefaults() bundle.Mutator {
+	return &applyWorkspaceDefaults{}
+}
+
+func (m *applyWorkspaceDefaults) Name() string {
+	return "ApplyWorkspaceDefaults"
+}
+
+func (m *applyWorkspaceDefaults) Apply(ctx context.Context, b *bundle.Bundle) error {
+	defaults := b.Config.Workspace.Defaults
+	if defaults == nil {
+		return nil
+	}
+
+	for _, job := range b.Config.Resources.Jobs {
+		if job.JobSettings == nil {
+			continue
+		}
+		for i := range job.JobClusters {
+			applyClusterDefaults(defaults, job.JobClusters[i].NewCluster)
+		}
+		for i := range job.Tasks {
+			applyClusterDefaults(defaults, job.Tasks[i].NewCluster)
+		}
+	}
+
+	return nil
+}
+
+func applyClusterDefaults(defaults *config.ClusterDefaults, cluster *compute.ClusterSpec) {
+	if cluster == nil {
+		return
+	}
+	if cluster.SparkVersion == "" {
+		cluster.SparkVersion = defaults.SparkVersion
+	}
+	if cluster.NodeTypeId == "" && cluster.InstancePoolId == "" {
+		cluster.NodeTypeId = defaults.NodeTypeId
+	}
+	if cluster.PolicyId == "" {
+		cluster.PolicyId = defaults.PolicyId
+	}
+}
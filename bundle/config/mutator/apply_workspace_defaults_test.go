@@ -0,0 +1,102 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyWorkspaceDefaults(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				Defaults: &config.ClusterDefaults{
+					SparkVersion: "14.3.x-scala2.12",
+					NodeTypeId:   "i3.xlarge",
+					PolicyId:     "policy",
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {JobSettings: &jobs.JobSettings{
+						JobClusters: []jobs.JobCluster{
+							{
+								JobClusterKey: "default",
+								NewCluster:    &compute.ClusterSpec{},
+							},
+							{
+								JobClusterKey: "pool",
+								NewCluster: &compute.ClusterSpec{
+									SparkVersion:   "13.3.x-scala2.12",
+									InstancePoolId: "pool",
+								},
+							},
+						},
+						Tasks: []jobs.Task{
+							{
+								TaskKey: "new_cluster",
+								NewCluster: &compute.ClusterSpec{
+									NodeTypeId: "m5.xlarge",
+									PolicyId:   "other",
+								},
+							},
+							{
+								TaskKey:           "existing_cluster",
+								ExistingClusterId: "cluster",
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ApplyWorkspaceDefaults())
+	require.NoError(t, err)
+
+	job := b.Config.Resources.Jobs["job1"]
+	assert.Equal(t, &compute.ClusterSpec{
+		SparkVersion: "14.3.x-scala2.12",
+		NodeTypeId:   "i3.xlarge",
+		PolicyId:     "policy",
+	}, job.JobClusters[0].NewCluster)
+	assert.Equal(t, &compute.ClusterSpec{
+		SparkVersion:   "13.3.x-scala2.12",
+		InstancePoolId: "pool",
+		PolicyId:       "policy",
+	}, job.JobClusters[1].NewCluster)
+	assert.Equal(t, &compute.ClusterSpec{
+		SparkVersion: "14.3.x-scala2.12",
+		NodeTypeId:   "m5.xlarge",
+		PolicyId:     "other",
+	}, job.Tasks[0].NewCluster)
+	assert.Nil(t, job.Tasks[1].NewCluster)
+}
+
+func TestApplyWorkspaceDefaultsWithoutDefaults(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {JobSettings: &jobs.JobSettings{
+						JobClusters: []jobs.JobCluster{
+							{NewCluster: &compute.ClusterSpec{}},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ApplyWorkspaceDefaults())
+	require.NoError(t, err)
+	assert.Equal(t, &compute.ClusterSpec{}, b.Config.Resources.Jobs["job1"].JobClusters[0].NewCluster)
+}
@@ -0,0 +1,90 @@
+package mutator
+
+import (
+	"context"
+	"slices"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"golang.org/x/exp/maps"
+)
+
+type applyWorkspaceTags struct{}
+
+// ApplyWorkspaceTags applies the tags defined in `workspace.tags` to all resources
+// that support tags. Tags defined on a resource take precedence.
+//
+// Pipelines don't support tags, so the tags are applied to their clusters instead.
+func ApplyWorkspaceTags() bundle.Mutator {
+	return &applyWorkspaceTags{}
+}
+
+func (m *applyWorkspaceTags) Name() string {
+	return "ApplyWorkspaceTags"
+}
+
+func (m *applyWorkspaceTags) Apply(ctx context.Context, b *bundle.Bundle) error {
+	tags := b.Config.Workspace.Tags
+	if len(tags) == 0 {
+		return nil
+	}
+
+	// Iterate over tags in a stable order for resources that store tags in a list.
+	keys := maps.Keys(tags)
+	slices.Sort(keys)
+
+	r := b.Config.Resources
+	for i := range r.Jobs {
+		if r.Jobs[i].JobSettings == nil {
+			continue
+		}
+		r.Jobs[i].Tags = mergeTags(tags, r.Jobs[i].Tags)
+	}
+
+	for i := range r.Pipelines {
+		if r.Pipelines[i].PipelineSpec == nil {
+			continue
+		}
+		for j := range r.Pipelines[i].Clusters {
+			r.Pipelines[i].Clusters[j].CustomTags = mergeTags(tags, r.Pipelines[i].Clusters[j].CustomTags)
+		}
+	}
+
+	for i := range r.Models {
+		if r.Models[i].Model == nil {
+			continue
+		}
+		for _, k := range keys {
+			if slices.ContainsFunc(r.Models[i].Tags, func(t ml.ModelTag) bool { return t.Key == k }) {
+				continue
+			}
+			r.Models[i].Tags = append(r.Models[i].Tags, ml.ModelTag{Key: k, Value: tags[k]})
+		}
+	}
+
+	for i := range r.Experiments {
+		if r.Experiments[i].Experiment == nil {
+			continue
+		}
+		for _, k := range keys {
+			if slices.ContainsFunc(r.Experiments[i].Tags, func(t ml.ExperimentTag) bool { return t.Key == k }) {
+				continue
+			}
+			r.Experiments[i].Tags = append(r.Experiments[i].Tags, ml.ExperimentTag{Key: k, Value: tags[k]})
+		}
+	}
+
+	return nil
+}
+
+// mergeTags returns the union of both tag maps where tags in override take precedence.
+func mergeTags(base, override map[string]string) map[string]string {
+	out := make(map[string]string, len(base)+len(override))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range override {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyWorkspaceTags(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				Tags: map[string]string{
+					"cost_center": "123",
+					"team":        "data",
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {JobSettings: &jobs.JobSettings{Name: "job1"}},
+					"job2": {JobSettings: &jobs.JobSettings{
+						Tags: map[string]string{
+							"team":  "ml",
+							"owner": "jane",
+						},
+					}},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"pipeline1": {PipelineSpec: &pipelines.PipelineSpec{
+						Clusters: []pipelines.PipelineCluster{
+							{Label: "default"},
+						},
+					}},
+				},
+				Models: map[string]*resources.MlflowModel{
+					"model1": {Model: &ml.Model{
+						Tags: []ml.ModelTag{{Key: "team", Value: "ml"}},
+					}},
+				},
+				Experiments: map[string]*resources.MlflowExperiment{
+					"experiment1": {Experiment: &ml.Experiment{Name: "experiment1"}},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ApplyWorkspaceTags())
+	require.NoError(t, err)
+
+	r := b.Config.Resources
+	assert.Equal(t, map[string]string{"cost_center": "123", "team": "data"}, r.Jobs["job1"].Tags)
+
+	// Tags defined on the resource take precedence.
+	assert.Equal(t, map[string]string{"cost_center": "123", "team": "ml", "owner": "jane"}, r.Jobs["job2"].Tags)
+
+	assert.Equal(t, map[string]string{"cost_center": "123", "team": "data"}, r.Pipelines["pipeline1"].Clusters[0].CustomTags)
+	assert.Equal(t, []ml.ModelTag{
+		{Key: "team", Value: "ml"},
+		{Key: "cost_center", Value: "123"},
+	}, r.Models["model1"].Tags)
+	assert.Equal(t, []ml.ExperimentTag{
+		{Key: "cost_center", Value: "123"},
+		{Key: "team", Value: "data"},
+	}, r.Experiments["experiment1"].Tags)
+}
+
+func TestApplyWorkspaceTagsWithoutTags(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {JobSettings: &jobs.JobSettings{Name: "job1"}},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ApplyWorkspaceTags())
+	require.NoError(t, err)
+	assert.Nil(t, b.Config.Resources.Jobs["job1"].Tags)
+}
@@ -6,6 +6,7 @@ import (
 	"path"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
 )
 
 type defineDefaultWorkspacePaths struct{}
@@ -25,12 +26,24 @@ func (m *defineDefaultWorkspacePaths) Apply(ctx context.Context, b *bundle.Bundl
 		return fmt.Errorf("unable to define default workspace paths: workspace root not defined")
 	}
 
+	if b.Config.Bundle.Deployment.Source == config.DeploymentSourceRepo {
+		if b.Config.Workspace.RepoPath == "" {
+			b.Config.Workspace.RepoPath = path.Join(root, "repo")
+		}
+
+		// The bundle root may be a subdirectory of the repository. Files are
+		// synchronized to, and paths are translated relative to, the bundle root.
+		if b.Config.Workspace.FilePath == "" {
+			b.Config.Workspace.FilePath = path.Join(b.Config.Workspace.RepoPath, b.Config.Bundle.Git.BundleRootPath)
+		}
+	}
+
 	if b.Config.Workspace.FilePath == "" {
 		b.Config.Workspace.FilePath = path.Join(root, "files")
 	}
 
 	if b.Config.Workspace.ArtifactPath == "" {
-		b.Config.Workspace.ArtifactPath = path.Join(root, "artifacts")
+		b.Config.Workspace.ArtifactPath = config.PathLike(path.Join(root, "artifacts"))
 	}
 
 	if b.Config.Workspace.StatePath == "" {
@@ -7,6 +7,7 @@ import (
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,7 +23,7 @@ func TestDefineDefaultWorkspacePaths(t *testing.T) {
 	err := bundle.Apply(context.Background(), b, mutator.DefineDefaultWorkspacePaths())
 	require.NoError(t, err)
 	assert.Equal(t, "/files", b.Config.Workspace.FilePath)
-	assert.Equal(t, "/artifacts", b.Config.Workspace.ArtifactPath)
+	assert.Equal(t, "/artifacts", b.Config.Workspace.ArtifactPath.String())
 	assert.Equal(t, "/state", b.Config.Workspace.StatePath)
 }
 
@@ -40,6 +41,80 @@ func TestDefineDefaultWorkspacePathsAlreadySet(t *testing.T) {
 	err := bundle.Apply(context.Background(), b, mutator.DefineDefaultWorkspacePaths())
 	require.NoError(t, err)
 	assert.Equal(t, "/foo/bar", b.Config.Workspace.FilePath)
-	assert.Equal(t, "/foo/bar", b.Config.Workspace.ArtifactPath)
+	assert.Equal(t, "/foo/bar", b.Config.Workspace.ArtifactPath.String())
 	assert.Equal(t, "/foo/bar", b.Config.Workspace.StatePath)
 }
+
+func TestDefineDefaultWorkspacePathsFromBundleNameAndUser(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name:   "my_bundle",
+				Target: "dev",
+			},
+			Workspace: config.Workspace{
+				CurrentUser: &config.User{
+					User: &iam.User{
+						UserName: "jane@doe.com",
+					},
+				},
+			},
+		},
+	}
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.DefineDefaultWorkspaceRoot(),
+		mutator.ExpandWorkspaceRoot(),
+		mutator.DefineDefaultWorkspacePaths(),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, "/Users/jane@doe.com/.bundle/my_bundle/dev/files", b.Config.Workspace.FilePath)
+	assert.Equal(t, "/Users/jane@doe.com/.bundle/my_bundle/dev/artifacts", b.Config.Workspace.ArtifactPath.String())
+	assert.Equal(t, "/Users/jane@doe.com/.bundle/my_bundle/dev/state", b.Config.Workspace.StatePath)
+}
+
+func TestDefineDefaultWorkspacePathsFromBundleNameAndUserWithOverride(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name:   "my_bundle",
+				Target: "dev",
+			},
+			Workspace: config.Workspace{
+				CurrentUser: &config.User{
+					User: &iam.User{
+						UserName: "jane@doe.com",
+					},
+				},
+				FilePath: "/Shared/my_bundle/files",
+			},
+		},
+	}
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.DefineDefaultWorkspaceRoot(),
+		mutator.ExpandWorkspaceRoot(),
+		mutator.DefineDefaultWorkspacePaths(),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, "/Shared/my_bundle/files", b.Config.Workspace.FilePath)
+	assert.Equal(t, "/Users/jane@doe.com/.bundle/my_bundle/dev/artifacts", b.Config.Workspace.ArtifactPath.String())
+}
+
+func TestDefineDefaultWorkspacePathsForRepoSource(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Deployment: config.Deployment{Source: config.DeploymentSourceRepo},
+				Git:        config.Git{BundleRootPath: "bundles/foo"},
+			},
+			Workspace: config.Workspace{
+				RootPath: "/",
+			},
+		},
+	}
+	err := bundle.Apply(context.Background(), b, mutator.DefineDefaultWorkspacePaths())
+	require.NoError(t, err)
+	assert.Equal(t, "/repo", b.Config.Workspace.RepoPath)
+	assert.Equal(t, "/repo/bundles/foo", b.Config.Workspace.FilePath)
+	assert.Equal(t, "/artifacts", b.Config.Workspace.ArtifactPath.String())
+	assert.Equal(t, "/state", b.Config.Workspace.StatePath)
+}
@@ -0,0 +1,86 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/env"
+)
+
+// Matches references to environment variables (`$FOO` and `${FOO}`).
+var environmentVariableReferenceRegex = regexp.MustCompile(`\$\{([a-zA-Z_][a-zA-Z0-9_]*)\}|\$([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// Patterns of fields that hold shell commands. These are evaluated by a shell
+// at execution time and must not be expanded here.
+var expandEnvironmentVariablesSkipPatterns = []dyn.Pattern{
+	dyn.NewPattern(dyn.Key("experimental"), dyn.Key("scripts")),
+	dyn.NewPattern(dyn.Key("artifacts"), dyn.AnyKey(), dyn.Key("build")),
+	dyn.NewPattern(dyn.Key("targets"), dyn.AnyKey(), dyn.Key("artifacts"), dyn.AnyKey(), dyn.Key("build")),
+	dyn.NewPattern(dyn.Key("environments"), dyn.AnyKey(), dyn.Key("artifacts"), dyn.AnyKey(), dyn.Key("build")),
+}
+
+type expandEnvironmentVariables struct{}
+
+// ExpandEnvironmentVariables expands shell-style references to environment variables
+// (`$FOO` and `${FOO}`) in all string values in the configuration.
+//
+// This is opt-in through the `experimental.expand_environment_variables` setting.
+// It is an error to reference an environment variable that is not set.
+//
+// Anything else that starts with a `$`, such as `${var.foo}`, `${file:path}` or a
+// malformed `${`, is left in place. This means that this expansion does not interfere
+// with references that are resolved later. Shell commands in `experimental.scripts`
+// and artifact `build` fields are not expanded.
+func ExpandEnvironmentVariables() bundle.Mutator {
+	return &expandEnvironmentVariables{}
+}
+
+func (m *expandEnvironmentVariables) Name() string {
+	return "ExpandEnvironmentVariables"
+}
+
+func (m *expandEnvironmentVariables) Apply(ctx context.Context, b *bundle.Bundle) error {
+	if b.Config.Experimental == nil || !b.Config.Experimental.ExpandEnvironmentVariables {
+		return nil
+	}
+
+	var undefined []string
+	err := b.Config.Mutate(func(root dyn.Value) (dyn.Value, error) {
+		return dyn.Walk(root, func(p dyn.Path, v dyn.Value) (dyn.Value, error) {
+			for _, pattern := range expandEnvironmentVariablesSkipPatterns {
+				if pattern.Matches(p) {
+					return v, dyn.ErrSkip
+				}
+			}
+
+			s, ok := v.AsString()
+			if !ok || !strings.Contains(s, "$") {
+				return v, nil
+			}
+
+			out := environmentVariableReferenceRegex.ReplaceAllStringFunc(s, func(ref string) string {
+				name := strings.Trim(ref, "${}")
+				value, ok := env.Lookup(ctx, name)
+				if !ok {
+					undefined = append(undefined, fmt.Sprintf("%s (at %s)", name, v.Location()))
+				}
+				return value
+			})
+
+			return dyn.NewValue(out, v.Location()), nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(undefined) > 0 {
+		return fmt.Errorf("undefined environment variables referenced in configuration: %s", strings.Join(undefined, ", "))
+	}
+
+	return nil
+}
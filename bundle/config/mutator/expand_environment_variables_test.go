@@ -0,0 +1,155 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/libs/env"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandEnvironmentVariables(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "${BUNDLE_NAME}-$SUFFIX",
+			},
+			Workspace: config.Workspace{
+				Host:     "$HOST",
+				RootPath: "/Users/${var.user}/${bundle.name}/$$",
+			},
+			Experimental: &config.Experimental{
+				ExpandEnvironmentVariables: true,
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = env.Set(ctx, "BUNDLE_NAME", "name")
+	ctx = env.Set(ctx, "SUFFIX", "suffix")
+	ctx = env.Set(ctx, "HOST", "https://example.cloud.databricks.com")
+
+	err := bundle.Apply(ctx, b, mutator.ExpandEnvironmentVariables())
+	require.NoError(t, err)
+	assert.Equal(t, "name-suffix", b.Config.Bundle.Name)
+	assert.Equal(t, "https://example.cloud.databricks.com", b.Config.Workspace.Host)
+
+	// References with a dot are not environment variables.
+	assert.Equal(t, "/Users/${var.user}/${bundle.name}/$$", b.Config.Workspace.RootPath)
+}
+
+func TestExpandEnvironmentVariablesUndefined(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "${DOES_NOT_EXIST}",
+			},
+			Workspace: config.Workspace{
+				Host: "$ALSO_DOES_NOT_EXIST",
+			},
+			Experimental: &config.Experimental{
+				ExpandEnvironmentVariables: true,
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ExpandEnvironmentVariables())
+	assert.ErrorContains(t, err, "undefined environment variables referenced in configuration: ")
+	assert.ErrorContains(t, err, "DOES_NOT_EXIST (at ")
+	assert.ErrorContains(t, err, "ALSO_DOES_NOT_EXIST (at ")
+}
+
+func TestExpandEnvironmentVariablesNotEnabled(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "${DOES_NOT_EXIST}",
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ExpandEnvironmentVariables())
+	require.NoError(t, err)
+	assert.Equal(t, "${DOES_NOT_EXIST}", b.Config.Bundle.Name)
+}
+
+func TestExpandEnvironmentVariablesLeavesOtherReferences(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "${file:config/name}",
+			},
+			Workspace: config.Workspace{
+				Host:     "${env.HOST}",
+				RootPath: "/Users/${ROOT",
+				FilePath: "$ROOT/${ROOT",
+			},
+			Experimental: &config.Experimental{
+				ExpandEnvironmentVariables: true,
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = env.Set(ctx, "ROOT", "root")
+
+	err := bundle.Apply(ctx, b, mutator.ExpandEnvironmentVariables())
+	require.NoError(t, err)
+	assert.Equal(t, "${file:config/name}", b.Config.Bundle.Name)
+	assert.Equal(t, "${env.HOST}", b.Config.Workspace.Host)
+	assert.Equal(t, "/Users/${ROOT", b.Config.Workspace.RootPath)
+	assert.Equal(t, "root/${ROOT", b.Config.Workspace.FilePath)
+}
+
+func TestExpandEnvironmentVariablesSkipsShellCommands(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Artifacts: config.Artifacts{
+				"whl": &config.Artifact{
+					BuildCommand: "python -m build --outdir $OUT_DIR",
+					Path:         "$ARTIFACT_PATH",
+				},
+			},
+			Experimental: &config.Experimental{
+				ExpandEnvironmentVariables: true,
+				Scripts: map[config.ScriptHook]config.Command{
+					config.ScriptPreBuild: "echo $HOME",
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = env.Set(ctx, "ARTIFACT_PATH", "./dist")
+
+	err := bundle.Apply(ctx, b, mutator.ExpandEnvironmentVariables())
+	require.NoError(t, err)
+	assert.Equal(t, "python -m build --outdir $OUT_DIR", b.Config.Artifacts["whl"].BuildCommand)
+	assert.Equal(t, "./dist", b.Config.Artifacts["whl"].Path)
+	assert.Equal(t, config.Command("echo $HOME"), b.Config.Experimental.Scripts[config.ScriptPreBuild])
+}
+
+func TestExpandEnvironmentVariablesBeforeResolvingSecrets(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				Token: "${SECRET:TOKEN}",
+			},
+			Experimental: &config.Experimental{
+				ExpandEnvironmentVariables: true,
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = env.Set(ctx, "TOKEN", "abc$def")
+
+	m := append([]bundle.Mutator{mutator.ExpandEnvironmentVariables()}, mutator.TargetMutators()...)
+	err := bundle.Apply(ctx, b, bundle.Seq(m...))
+	require.NoError(t, err)
+	assert.Equal(t, "abc$def", string(b.Config.Workspace.Token))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/libraries"
@@ -46,8 +47,12 @@ func (m *expandPipelineGlobPaths) expandLibrary(v dyn.Value) ([]dyn.Value, error
 			return nil, err
 		}
 
-		// If there are no matches, return the original value.
+		// A pattern that matches nothing is likely a mistake.
+		// If the path is not a pattern, return the original value.
 		if len(matches) == 0 {
+			if strings.ContainsAny(path, "*?[") {
+				return nil, fmt.Errorf("%s: pattern %s does not match any files", pv.Location(), path)
+			}
 			return []dyn.Value{v}, nil
 		}
 
@@ -139,6 +139,82 @@ func TestExpandGlobPathsInPipelines(t *testing.T) {
 	require.True(t, containsNotebook(libraries, "./non-existent.ipynb"))
 }
 
+func TestExpandGlobPathsInPipelinesNoMatches(t *testing.T) {
+	dir := t.TempDir()
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Resources: config.Resources{
+				Pipelines: map[string]*resources.Pipeline{
+					"pipeline": {
+						PipelineSpec: &pipelines.PipelineSpec{
+							Libraries: []pipelines.PipelineLibrary{
+								{
+									Notebook: &pipelines.NotebookLibrary{
+										Path: "./notebooks/*.py",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, ".", filepath.Join(dir, "resource.yml"))
+
+	m := ExpandPipelineGlobPaths()
+	err := bundle.Apply(context.Background(), b, m)
+	require.ErrorContains(t, err, "pattern ./notebooks/*.py does not match any files")
+}
+
+func TestExpandGlobPathsInPipelinesIsSorted(t *testing.T) {
+	dir := t.TempDir()
+
+	touchEmptyFile(t, filepath.Join(dir, "notebooks/c.py"))
+	touchEmptyFile(t, filepath.Join(dir, "notebooks/a.py"))
+	touchEmptyFile(t, filepath.Join(dir, "notebooks/b.py"))
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Resources: config.Resources{
+				Pipelines: map[string]*resources.Pipeline{
+					"pipeline": {
+						PipelineSpec: &pipelines.PipelineSpec{
+							Libraries: []pipelines.PipelineLibrary{
+								{
+									Notebook: &pipelines.NotebookLibrary{
+										Path: "./notebooks/*.py",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, ".", filepath.Join(dir, "resource.yml"))
+
+	m := ExpandPipelineGlobPaths()
+	err := bundle.Apply(context.Background(), b, m)
+	require.NoError(t, err)
+
+	var paths []string
+	for _, l := range b.Config.Resources.Pipelines["pipeline"].Libraries {
+		paths = append(paths, l.Notebook.Path)
+	}
+	require.Equal(t, []string{
+		filepath.Join("notebooks", "a.py"),
+		filepath.Join("notebooks", "b.py"),
+		filepath.Join("notebooks", "c.py"),
+	}, paths)
+}
+
 func containsNotebook(libraries []pipelines.PipelineLibrary, path string) bool {
 	for _, l := range libraries {
 		if l.Notebook != nil && l.Notebook.Path == path {
@@ -0,0 +1,218 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+	"github.com/databricks/cli/libs/log"
+)
+
+// resourceSelectorTypes maps the resource type used in selectors
+// such as "job:my_job" to the corresponding key under "resources".
+var resourceSelectorTypes = map[string]string{
+	"job":                    "jobs",
+	"pipeline":               "pipelines",
+	"model":                  "models",
+	"experiment":             "experiments",
+	"model_serving_endpoint": "model_serving_endpoints",
+	"registered_model":       "registered_models",
+}
+
+// resourceID identifies a resource by its type (e.g. "jobs") and key.
+type resourceID struct {
+	typ string
+	key string
+}
+
+func (id resourceID) String() string {
+	for singular, plural := range resourceSelectorTypes {
+		if plural == id.typ {
+			return singular + ":" + id.key
+		}
+	}
+	return id.typ + ":" + id.key
+}
+
+func parseResourceSelector(s string) (resourceID, error) {
+	typ, key, ok := strings.Cut(s, ":")
+	if !ok || key == "" {
+		return resourceID{}, fmt.Errorf("invalid resource selector %q: expected <type>:<key>, for example job:my_job", s)
+	}
+
+	plural, ok := resourceSelectorTypes[typ]
+	if !ok {
+		var types []string
+		for t := range resourceSelectorTypes {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+		return resourceID{}, fmt.Errorf("invalid resource selector %q: unknown resource type %q, must be one of %s", s, typ, strings.Join(types, ", "))
+	}
+
+	return resourceID{typ: plural, key: key}, nil
+}
+
+type filterResources struct{}
+
+// FilterResources removes resources that are not selected by the `only`
+// and `exclude` selectors. Resources that a selected resource refers to
+// through a ${resources...} reference are retained as well. Excluding a
+// resource that a retained resource refers to is an error.
+func FilterResources() bundle.Mutator {
+	return &filterResources{}
+}
+
+func (m *filterResources) Name() string {
+	return "FilterResources"
+}
+
+func (m *filterResources) Apply(ctx context.Context, b *bundle.Bundle) error {
+	if !b.Config.Bundle.IsFiltered() {
+		return nil
+	}
+
+	return b.Config.Mutate(func(root dyn.Value) (dyn.Value, error) {
+		resources, err := dyn.Get(root, "resources")
+		if err != nil {
+			return root, nil
+		}
+
+		deps, err := resourceDependencies(resources)
+		if err != nil {
+			return dyn.InvalidValue, err
+		}
+
+		only, err := lookupSelectors(b.Config.Bundle.Only, deps)
+		if err != nil {
+			return dyn.InvalidValue, err
+		}
+		exclude, err := lookupSelectors(b.Config.Bundle.Exclude, deps)
+		if err != nil {
+			return dyn.InvalidValue, err
+		}
+
+		// Retain the selected resources and everything they depend on.
+		keep := make(map[resourceID]bool)
+		if len(only) == 0 {
+			for id := range deps {
+				keep[id] = true
+			}
+		} else {
+			var visit func(id resourceID)
+			visit = func(id resourceID) {
+				if keep[id] {
+					return
+				}
+				keep[id] = true
+				for _, dep := range deps[id] {
+					visit(dep)
+				}
+			}
+			for _, id := range only {
+				visit(id)
+			}
+		}
+
+		for _, id := range exclude {
+			delete(keep, id)
+		}
+
+		// Excluded resources must not be needed by any retained resource.
+		for id := range keep {
+			for _, dep := range deps[id] {
+				if !keep[dep] {
+					return dyn.InvalidValue, fmt.Errorf("cannot exclude %s: it is referenced by %s", dep, id)
+				}
+			}
+		}
+
+		out := make(map[string]dyn.Value)
+		for typ, v := range resources.MustMap() {
+			m, ok := v.AsMap()
+			if !ok {
+				out[typ] = v
+				continue
+			}
+			filtered := make(map[string]dyn.Value)
+			for key, r := range m {
+				if keep[resourceID{typ, key}] {
+					filtered[key] = r
+					continue
+				}
+				log.Debugf(ctx, "Skipping %s", resourceID{typ, key})
+			}
+			if len(filtered) > 0 {
+				out[typ] = dyn.NewValue(filtered, v.Location())
+			}
+		}
+
+		return dyn.SetByPath(root, dyn.NewPath(dyn.Key("resources")), dyn.NewValue(out, resources.Location()))
+	})
+}
+
+// lookupSelectors parses the selectors and checks that the resources they refer to exist.
+func lookupSelectors(selectors []string, deps map[resourceID][]resourceID) ([]resourceID, error) {
+	var out []resourceID
+	for _, s := range selectors {
+		id, err := parseResourceSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := deps[id]; !ok {
+			return nil, fmt.Errorf("resource %s not found", id)
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+// resourceDependencies returns all resources, each mapped to the
+// resources it refers to through ${resources.<type>.<key>...} references.
+func resourceDependencies(resources dyn.Value) (map[resourceID][]resourceID, error) {
+	deps := make(map[resourceID][]resourceID)
+	types, ok := resources.AsMap()
+	if !ok {
+		return deps, nil
+	}
+
+	for typ, v := range types {
+		m, ok := v.AsMap()
+		if !ok {
+			continue
+		}
+		for key := range m {
+			deps[resourceID{typ, key}] = nil
+		}
+	}
+
+	for id := range deps {
+		v := types[id.typ].MustMap()[id.key]
+		_, err := dyn.Walk(v, func(p dyn.Path, v dyn.Value) (dyn.Value, error) {
+			s, ok := v.AsString()
+			if !ok {
+				return v, nil
+			}
+			for _, ref := range dynvar.References(s) {
+				parts := strings.Split(ref, ".")
+				if len(parts) < 3 || parts[0] != "resources" {
+					continue
+				}
+				dep := resourceID{parts[1], parts[2]}
+				if _, ok := deps[dep]; ok && dep != id {
+					deps[id] = append(deps[id], dep)
+				}
+			}
+			return v, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return deps, nil
+}
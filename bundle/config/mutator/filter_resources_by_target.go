@@ -0,0 +1,41 @@
+package mutator
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/log"
+)
+
+type filterResourcesByTarget struct{}
+
+// FilterResourcesByTarget removes jobs and pipelines whose `when` condition
+// does not include the selected target.
+func FilterResourcesByTarget() bundle.Mutator {
+	return &filterResourcesByTarget{}
+}
+
+func (m *filterResourcesByTarget) Name() string {
+	return "FilterResourcesByTarget"
+}
+
+func (m *filterResourcesByTarget) Apply(ctx context.Context, b *bundle.Bundle) error {
+	target := b.Config.Bundle.Target
+	r := &b.Config.Resources
+
+	for key, job := range r.Jobs {
+		if !job.When.Matches(target) {
+			log.Debugf(ctx, "Skipping job %s for target %q", key, target)
+			delete(r.Jobs, key)
+		}
+	}
+
+	for key, pipeline := range r.Pipelines {
+		if !pipeline.When.Matches(target) {
+			log.Debugf(ctx, "Skipping pipeline %s for target %q", key, target)
+			delete(r.Pipelines, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+func mockFilterResourcesBundle(only, exclude []string) *bundle.Bundle {
+	return &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Only:    only,
+				Exclude: exclude,
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"ingest": {JobSettings: &jobs.JobSettings{
+						Name: "ingest",
+						Tasks: []jobs.Task{
+							{
+								TaskKey: "refresh",
+								PipelineTask: &jobs.PipelineTask{
+									PipelineId: "${resources.pipelines.etl.id}",
+								},
+							},
+						},
+					}},
+					"report": {JobSettings: &jobs.JobSettings{Name: "report"}},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"etl": {PipelineSpec: &pipelines.PipelineSpec{Name: "etl"}},
+				},
+				Experiments: map[string]*resources.MlflowExperiment{
+					"experiment": {Experiment: &ml.Experiment{Name: "experiment"}},
+				},
+			},
+		},
+	}
+}
+
+func TestFilterResourcesNoSelectors(t *testing.T) {
+	b := mockFilterResourcesBundle(nil, nil)
+	err := bundle.Apply(context.Background(), b, mutator.FilterResources())
+	require.NoError(t, err)
+	assert.Len(t, b.Config.Resources.Jobs, 2)
+	assert.Len(t, b.Config.Resources.Pipelines, 1)
+	assert.Len(t, b.Config.Resources.Experiments, 1)
+}
+
+func TestFilterResourcesOnlyRetainsDependencies(t *testing.T) {
+	b := mockFilterResourcesBundle([]string{"job:ingest"}, nil)
+	err := bundle.Apply(context.Background(), b, mutator.FilterResources())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ingest"}, maps.Keys(b.Config.Resources.Jobs))
+	assert.Equal(t, []string{"etl"}, maps.Keys(b.Config.Resources.Pipelines))
+	assert.Empty(t, b.Config.Resources.Experiments)
+}
+
+func TestFilterResourcesExclude(t *testing.T) {
+	b := mockFilterResourcesBundle(nil, []string{"job:ingest", "experiment:experiment"})
+	err := bundle.Apply(context.Background(), b, mutator.FilterResources())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"report"}, maps.Keys(b.Config.Resources.Jobs))
+	assert.Equal(t, []string{"etl"}, maps.Keys(b.Config.Resources.Pipelines))
+	assert.Empty(t, b.Config.Resources.Experiments)
+}
+
+func TestFilterResourcesExcludeDependency(t *testing.T) {
+	b := mockFilterResourcesBundle(nil, []string{"pipeline:etl"})
+	err := bundle.Apply(context.Background(), b, mutator.FilterResources())
+	assert.EqualError(t, err, "cannot exclude pipeline:etl: it is referenced by job:ingest")
+
+	// Excluding both the resource and the resources referring to it is fine.
+	b = mockFilterResourcesBundle(nil, []string{"pipeline:etl", "job:ingest"})
+	err = bundle.Apply(context.Background(), b, mutator.FilterResources())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"report"}, maps.Keys(b.Config.Resources.Jobs))
+	assert.Empty(t, b.Config.Resources.Pipelines)
+}
+
+func TestFilterResourcesInvalidSelectors(t *testing.T) {
+	b := mockFilterResourcesBundle([]string{"my_job"}, nil)
+	err := bundle.Apply(context.Background(), b, mutator.FilterResources())
+	assert.EqualError(t, err, `invalid resource selector "my_job": expected <type>:<key>, for example job:my_job`)
+
+	b = mockFilterResourcesBundle([]string{"jobs:ingest"}, nil)
+	err = bundle.Apply(context.Background(), b, mutator.FilterResources())
+	assert.ErrorContains(t, err, `unknown resource type "jobs"`)
+
+	b = mockFilterResourcesBundle(nil, []string{"job:unknown"})
+	err = bundle.Apply(context.Background(), b, mutator.FilterResources())
+	assert.EqualError(t, err, "resource job:unknown not found")
+}
@@ -10,6 +10,7 @@ func DefaultMutators() []bundle.Mutator {
 	return []bundle.Mutator{
 		scripts.Execute(config.ScriptPreInit),
 		ProcessRootIncludes(),
+		ExpandEnvironmentVariables(),
 		EnvironmentsToTargets(),
 		InitializeVariables(),
 		DefineDefaultTarget(),
@@ -17,9 +18,19 @@ func DefaultMutators() []bundle.Mutator {
 	}
 }
 
+// TargetMutators returns the mutators that run after a target has been selected.
+// They only apply to the configuration of the selected target, such that
+// references in other targets do not need to be resolvable.
+func TargetMutators() []bundle.Mutator {
+	return []bundle.Mutator{
+		ResolveEnvReferences(),
+		ResolveSecretReferences(),
+	}
+}
+
 func DefaultMutatorsForTarget(target string) []bundle.Mutator {
 	return append(
-		DefaultMutators(),
-		SelectTarget(target),
+		append(DefaultMutators(), SelectTarget(target)),
+		TargetMutators()...,
 	)
 }
@@ -3,11 +3,13 @@ package mutator
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/resources"
 	"github.com/databricks/cli/libs/env"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
 )
 
 type overrideCompute struct{}
@@ -30,6 +32,21 @@ func overrideJobCompute(j *resources.Job, compute string) {
 			task.ExistingClusterId = compute
 		}
 	}
+
+	// Remove job clusters and compute specifications that are no longer used by any task.
+	// The Jobs API does not accept job clusters that are not referenced.
+	clusterKeys := make(map[string]bool)
+	computeKeys := make(map[string]bool)
+	for _, task := range j.Tasks {
+		clusterKeys[task.JobClusterKey] = true
+		computeKeys[task.ComputeKey] = true
+	}
+	j.JobClusters = slices.DeleteFunc(j.JobClusters, func(c jobs.JobCluster) bool {
+		return !clusterKeys[c.JobClusterKey]
+	})
+	j.Compute = slices.DeleteFunc(j.Compute, func(c jobs.JobCompute) bool {
+		return !computeKeys[c.ComputeKey]
+	})
 }
 
 func (m *overrideCompute) Apply(ctx context.Context, b *bundle.Bundle) error {
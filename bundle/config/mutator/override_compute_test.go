@@ -62,6 +62,45 @@ func TestOverrideDevelopment(t *testing.T) {
 	assert.Empty(t, b.Config.Resources.Jobs["job1"].Tasks[3].JobClusterKey)
 }
 
+func TestOverrideDevelopmentRemovesUnusedJobClusters(t *testing.T) {
+	t.Setenv("DATABRICKS_CLUSTER_ID", "")
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Mode:      config.Development,
+				ComputeID: "newClusterID",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {JobSettings: &jobs.JobSettings{
+						Name: "job1",
+						JobClusters: []jobs.JobCluster{
+							{JobClusterKey: "cluster_key"},
+						},
+						Compute: []jobs.JobCompute{
+							{ComputeKey: "compute_key"},
+						},
+						Tasks: []jobs.Task{
+							{
+								JobClusterKey: "cluster_key",
+							},
+							{
+								ComputeKey: "compute_key",
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	m := mutator.OverrideCompute()
+	err := bundle.Apply(context.Background(), b, m)
+	require.NoError(t, err)
+	assert.Empty(t, b.Config.Resources.Jobs["job1"].JobClusters)
+	assert.Empty(t, b.Config.Resources.Jobs["job1"].Compute)
+}
+
 func TestOverrideDevelopmentEnv(t *testing.T) {
 	t.Setenv("DATABRICKS_CLUSTER_ID", "newClusterId")
 	b := &bundle.Bundle{
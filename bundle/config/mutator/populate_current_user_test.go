@@ -1,9 +1,73 @@
 package mutator
 
 import (
+	"context"
 	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	sdkconfig "github.com/databricks/databricks-sdk-go/config"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPopulateCurrentUser(t *testing.T) {
-	// We need to implement workspace client mocking to implement this test.
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				RootPath: "/Users/${workspace.current_user.userName}/.bundle",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {JobSettings: &jobs.JobSettings{
+						Name: "[${workspace.current_user.short_name}] job (${workspace.current_user.id})",
+					}},
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	m.WorkspaceClient.Config = &sdkconfig.Config{Host: "https://company.cloud.databricks.com"}
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{
+		Id:       "1234",
+		UserName: "jane.doe@company.com",
+	}, nil).Once()
+
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		PopulateCurrentUser(),
+		ResolveVariableReferences("workspace"),
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, "jane_doe", b.Config.Workspace.CurrentUser.ShortName)
+	assert.Equal(t, "/Users/jane.doe@company.com/.bundle", b.Config.Workspace.RootPath)
+	assert.Equal(t, "[jane_doe] job (1234)", b.Config.Resources.Jobs["job"].Name)
+}
+
+func TestPopulateCurrentUserIsNoopIfSet(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				CurrentUser: &config.User{
+					ShortName: "jane_doe",
+					User:      &iam.User{UserName: "jane.doe@company.com"},
+				},
+			},
+		},
+	}
+
+	// The mock fails the test if the API is called.
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	err := bundle.Apply(context.Background(), b, PopulateCurrentUser())
+	require.NoError(t, err)
+	assert.Equal(t, "jane_doe", b.Config.Workspace.CurrentUser.ShortName)
 }
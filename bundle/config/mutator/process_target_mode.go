@@ -10,14 +10,19 @@ import (
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/libs/auth"
 	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
 )
 
 type processTargetMode struct{}
 
 const developmentConcurrentRuns = 4
 
+// Maximum number of workers for clusters deployed in development mode.
+const developmentMaxWorkers = 4
+
 func ProcessTargetMode() bundle.Mutator {
 	return &processTargetMode{}
 }
@@ -28,12 +33,16 @@ func (m *processTargetMode) Name() string {
 
 // Mark all resources as being for 'development' purposes, i.e.
 // changing their their name, adding tags, and (in the future)
-// marking them as 'hidden' in the UI.
+// marking them as 'hidden' in the UI. Schedules are paused and
+// cluster sizes are capped to avoid production-scale runs.
 func transformDevelopmentMode(b *bundle.Bundle) error {
 	r := b.Config.Resources
 
 	shortName := b.Config.Workspace.CurrentUser.ShortName
 	prefix := "[dev " + shortName + "] "
+	if b.Config.Bundle.NamePrefix != "" {
+		prefix = strings.TrimSpace(b.Config.Bundle.NamePrefix) + " "
+	}
 
 	// Generate a normalized version of the short name that can be used as a tag value.
 	tagValue := b.Tagging.NormalizeValue(shortName)
@@ -60,12 +69,25 @@ func transformDevelopmentMode(b *bundle.Bundle) error {
 		if r.Jobs[i].Trigger != nil && r.Jobs[i].Trigger.PauseStatus != jobs.PauseStatusUnpaused {
 			r.Jobs[i].Trigger.PauseStatus = jobs.PauseStatusPaused
 		}
+
+		for j := range r.Jobs[i].JobClusters {
+			capJobClusterSize(r.Jobs[i].JobClusters[j].NewCluster)
+		}
+		for j := range r.Jobs[i].Tasks {
+			capJobClusterSize(r.Jobs[i].Tasks[j].NewCluster)
+		}
 	}
 
 	for i := range r.Pipelines {
 		r.Pipelines[i].Name = prefix + r.Pipelines[i].Name
 		r.Pipelines[i].Development = true
+		// Continuous pipelines run until stopped; only run them when triggered.
+		r.Pipelines[i].Continuous = false
 		// (pipelines don't yet support tags)
+
+		for j := range r.Pipelines[i].Clusters {
+			capPipelineClusterSize(&r.Pipelines[i].Clusters[j])
+		}
 	}
 
 	for i := range r.Models {
@@ -100,6 +122,27 @@ func transformDevelopmentMode(b *bundle.Bundle) error {
 	return nil
 }
 
+// capJobClusterSize limits the number of workers of a job cluster to [developmentMaxWorkers].
+func capJobClusterSize(c *compute.ClusterSpec) {
+	if c == nil {
+		return
+	}
+	c.NumWorkers = min(c.NumWorkers, developmentMaxWorkers)
+	if c.Autoscale != nil {
+		c.Autoscale.MinWorkers = min(c.Autoscale.MinWorkers, developmentMaxWorkers)
+		c.Autoscale.MaxWorkers = min(c.Autoscale.MaxWorkers, developmentMaxWorkers)
+	}
+}
+
+// capPipelineClusterSize limits the number of workers of a pipeline cluster to [developmentMaxWorkers].
+func capPipelineClusterSize(c *pipelines.PipelineCluster) {
+	c.NumWorkers = min(c.NumWorkers, developmentMaxWorkers)
+	if c.Autoscale != nil {
+		c.Autoscale.MinWorkers = min(c.Autoscale.MinWorkers, developmentMaxWorkers)
+		c.Autoscale.MaxWorkers = min(c.Autoscale.MaxWorkers, developmentMaxWorkers)
+	}
+}
+
 func validateDevelopmentMode(b *bundle.Bundle) error {
 	if path := findNonUserPath(b); path != "" {
 		return fmt.Errorf("%s must start with '~/' or contain the current username when using 'mode: development'", path)
@@ -119,7 +162,7 @@ func findNonUserPath(b *bundle.Bundle) string {
 	if b.Config.Workspace.FilePath != "" && !strings.Contains(b.Config.Workspace.FilePath, username) {
 		return "file_path"
 	}
-	if b.Config.Workspace.ArtifactPath != "" && !strings.Contains(b.Config.Workspace.ArtifactPath, username) {
+	if b.Config.Workspace.ArtifactPath != "" && !strings.Contains(b.Config.Workspace.ArtifactPath.String(), username) {
 		return "artifact_path"
 	}
 	return ""
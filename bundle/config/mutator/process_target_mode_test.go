@@ -12,6 +12,7 @@ import (
 	"github.com/databricks/cli/libs/tags"
 	sdkconfig "github.com/databricks/databricks-sdk-go/config"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/ml"
@@ -106,6 +107,18 @@ func mockBundle(mode config.Mode) *bundle.Bundle {
 	}
 }
 
+func TestProcessTargetModeDevelopmentWithNamePrefix(t *testing.T) {
+	b := mockBundle(config.Development)
+	b.Config.Bundle.NamePrefix = "[sandbox]"
+
+	m := ProcessTargetMode()
+	err := bundle.Apply(context.Background(), b, m)
+	require.NoError(t, err)
+
+	assert.Equal(t, "[sandbox] job1", b.Config.Resources.Jobs["job1"].Name)
+	assert.Equal(t, "[sandbox] pipeline1", b.Config.Resources.Pipelines["pipeline1"].Name)
+}
+
 func TestProcessTargetModeDevelopment(t *testing.T) {
 	b := mockBundle(config.Development)
 
@@ -189,6 +202,49 @@ func TestProcessTargetModeDevelopmentTagNormalizationForGcp(t *testing.T) {
 	assert.Equal(t, "Hello_world", b.Config.Resources.Jobs["job1"].Tags["dev"])
 }
 
+func TestProcessTargetModeDevelopmentCapsClusterSize(t *testing.T) {
+	b := mockBundle(config.Development)
+	b.Config.Resources.Jobs["job1"].JobClusters = []jobs.JobCluster{
+		{NewCluster: &compute.ClusterSpec{NumWorkers: 10}},
+		{NewCluster: &compute.ClusterSpec{Autoscale: &compute.AutoScale{MinWorkers: 2, MaxWorkers: 20}}},
+	}
+	b.Config.Resources.Jobs["job1"].Tasks = []jobs.Task{
+		{NewCluster: &compute.ClusterSpec{NumWorkers: 1}},
+	}
+	b.Config.Resources.Pipelines["pipeline1"].Continuous = true
+	b.Config.Resources.Pipelines["pipeline1"].Clusters = []pipelines.PipelineCluster{
+		{NumWorkers: 8},
+		{Autoscale: &pipelines.PipelineClusterAutoscale{MinWorkers: 5, MaxWorkers: 10}},
+	}
+
+	err := bundle.Apply(context.Background(), b, ProcessTargetMode())
+	require.NoError(t, err)
+
+	job := b.Config.Resources.Jobs["job1"]
+	assert.Equal(t, 4, job.JobClusters[0].NewCluster.NumWorkers)
+	assert.Equal(t, &compute.AutoScale{MinWorkers: 2, MaxWorkers: 4}, job.JobClusters[1].NewCluster.Autoscale)
+	assert.Equal(t, 1, job.Tasks[0].NewCluster.NumWorkers)
+
+	pipeline := b.Config.Resources.Pipelines["pipeline1"]
+	assert.False(t, pipeline.Continuous)
+	assert.Equal(t, 4, pipeline.Clusters[0].NumWorkers)
+	assert.Equal(t, 4, pipeline.Clusters[1].Autoscale.MinWorkers)
+	assert.Equal(t, 4, pipeline.Clusters[1].Autoscale.MaxWorkers)
+}
+
+func TestProcessTargetModeDefaultDoesNotCapClusterSize(t *testing.T) {
+	b := mockBundle("")
+	b.Config.Resources.Jobs["job1"].JobClusters = []jobs.JobCluster{
+		{NewCluster: &compute.ClusterSpec{NumWorkers: 10}},
+	}
+	b.Config.Resources.Pipelines["pipeline1"].Continuous = true
+
+	err := bundle.Apply(context.Background(), b, ProcessTargetMode())
+	require.NoError(t, err)
+	assert.Equal(t, 10, b.Config.Resources.Jobs["job1"].JobClusters[0].NewCluster.NumWorkers)
+	assert.True(t, b.Config.Resources.Pipelines["pipeline1"].Continuous)
+}
+
 func TestProcessTargetModeDefault(t *testing.T) {
 	b := mockBundle("")
 
@@ -0,0 +1,14 @@
+package mutator
+
+import (
+	"github.com/databricks/cli/bundle"
+)
+
+// ResolveDefinitions replaces references to the `definitions` section
+// with the referenced configuration blocks.
+//
+// It runs before all other initialization mutators such that they
+// operate on the resolved configuration.
+func ResolveDefinitions() bundle.Mutator {
+	return ResolveVariableReferences("definitions")
+}
@@ -0,0 +1,80 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+	"github.com/databricks/cli/libs/env"
+)
+
+type resolveEnvReferences struct{}
+
+// ResolveEnvReferences replaces references to environment variables
+// (e.g. `${env.FOO}`) with their values once a target has been selected.
+// References in targets that are not selected are not resolved.
+//
+// Environment variables can be declared in the `env` section to specify a default
+// value or to mark them as required. It is an error to reference an environment
+// variable that is not set and has no default, or to not set a required one.
+// All missing environment variables are reported together.
+func ResolveEnvReferences() bundle.Mutator {
+	return &resolveEnvReferences{}
+}
+
+func (m *resolveEnvReferences) Name() string {
+	return "ResolveEnvReferences"
+}
+
+func (m *resolveEnvReferences) Apply(ctx context.Context, b *bundle.Bundle) error {
+	missing := make(map[string]bool)
+
+	for name, v := range b.Config.Env {
+		if v == nil || !v.Required {
+			continue
+		}
+		if v.Default != nil {
+			return fmt.Errorf("environment variable %s is required and cannot have a default", name)
+		}
+		if _, ok := env.Lookup(ctx, name); !ok {
+			missing[name] = true
+		}
+	}
+
+	err := b.Config.Mutate(func(root dyn.Value) (dyn.Value, error) {
+		return dynvar.Resolve(root, func(path dyn.Path) (dyn.Value, error) {
+			if len(path) != 2 || path[0] != dyn.Key("env") {
+				return dyn.InvalidValue, dynvar.ErrSkipResolution
+			}
+
+			name := path[1].Key()
+			if value, ok := env.Lookup(ctx, name); ok {
+				return dyn.V(value), nil
+			}
+			if v := b.Config.Env[name]; v != nil && v.Default != nil {
+				return dyn.V(*v.Default), nil
+			}
+
+			missing[name] = true
+			return dyn.InvalidValue, dynvar.ErrSkipResolution
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(missing) > 0 {
+		var names []string
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("missing required environment variables: %s", strings.Join(names, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/libs/env"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveEnvReferences(t *testing.T) {
+	defaultHost := "https://default.cloud.databricks.com"
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "${env.BUNDLE_TEST_NAME}-${var.suffix}",
+			},
+			Workspace: config.Workspace{
+				Host:     "${env.BUNDLE_TEST_HOST}",
+				RootPath: "/Shared/${env.BUNDLE_TEST_NAME}",
+			},
+			Env: map[string]*config.EnvVariable{
+				"BUNDLE_TEST_HOST": {
+					Default: &defaultHost,
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = env.Set(ctx, "BUNDLE_TEST_NAME", "foo")
+
+	err := bundle.Apply(ctx, b, mutator.ResolveEnvReferences())
+	require.NoError(t, err)
+
+	// References to other sections are left in place.
+	assert.Equal(t, "foo-${var.suffix}", b.Config.Bundle.Name)
+	assert.Equal(t, "/Shared/foo", b.Config.Workspace.RootPath)
+	assert.Equal(t, defaultHost, b.Config.Workspace.Host)
+}
+
+func TestResolveEnvReferencesEnvironmentTakesPrecedence(t *testing.T) {
+	defaultHost := "https://default.cloud.databricks.com"
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				Host: "${env.BUNDLE_TEST_HOST}",
+			},
+			Env: map[string]*config.EnvVariable{
+				"BUNDLE_TEST_HOST": {
+					Default: &defaultHost,
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = env.Set(ctx, "BUNDLE_TEST_HOST", "https://other.cloud.databricks.com")
+
+	err := bundle.Apply(ctx, b, mutator.ResolveEnvReferences())
+	require.NoError(t, err)
+	assert.Equal(t, "https://other.cloud.databricks.com", b.Config.Workspace.Host)
+}
+
+func TestResolveEnvReferencesMissing(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "${env.BUNDLE_TEST_UNDEFINED_NAME}",
+			},
+			Workspace: config.Workspace{
+				Host: "${env.BUNDLE_TEST_UNDEFINED_HOST}",
+			},
+			Env: map[string]*config.EnvVariable{
+				"BUNDLE_TEST_UNDEFINED_TOKEN": {
+					Required: true,
+				},
+				"BUNDLE_TEST_UNDEFINED_OPTIONAL": {},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ResolveEnvReferences())
+	assert.EqualError(t, err, "missing required environment variables: BUNDLE_TEST_UNDEFINED_HOST, BUNDLE_TEST_UNDEFINED_NAME, BUNDLE_TEST_UNDEFINED_TOKEN")
+}
+
+func TestResolveEnvReferencesRequiredWithDefault(t *testing.T) {
+	value := "foo"
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Env: map[string]*config.EnvVariable{
+				"BUNDLE_TEST_NAME": {
+					Default:  &value,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ResolveEnvReferences())
+	assert.EqualError(t, err, "environment variable BUNDLE_TEST_NAME is required and cannot have a default")
+}
+
+func TestResolveEnvReferencesOnlyInSelectedTarget(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Targets: map[string]*config.Target{
+				"dev": {
+					Workspace: &config.Workspace{
+						Host: "${env.BUNDLE_TEST_DEV_HOST}",
+					},
+				},
+				"prod": {
+					Workspace: &config.Workspace{
+						Host: "${env.BUNDLE_TEST_PROD_HOST}",
+					},
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = env.Set(ctx, "BUNDLE_TEST_DEV_HOST", "https://dev.cloud.databricks.com")
+
+	m := append([]bundle.Mutator{mutator.SelectTarget("dev")}, mutator.TargetMutators()...)
+	err := bundle.Apply(ctx, b, bundle.Seq(m...))
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.cloud.databricks.com", b.Config.Workspace.Host)
+}
@@ -0,0 +1,88 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/env"
+)
+
+type resolveSecretReferences struct{}
+
+// tokenPath is the path of the workspace token, which may only hold a reference to a secret.
+var tokenPath = dyn.NewPath(dyn.Key("workspace"), dyn.Key("token"))
+
+type resolvedSecret struct {
+	path  dyn.Path
+	value string
+}
+
+// ResolveSecretReferences replaces references to secrets in the environment
+// (e.g. `${SECRET:DATABRICKS_TOKEN}`) with their values.
+//
+// Resolved values are recorded with the configuration such that they are
+// redacted when the configuration is exported. It is an error to reference a
+// secret that is not set, or to specify the workspace token without a reference.
+// Only secrets referenced by the selected target are resolved.
+func ResolveSecretReferences() bundle.Mutator {
+	return &resolveSecretReferences{}
+}
+
+func (m *resolveSecretReferences) Name() string {
+	return "ResolveSecretReferences"
+}
+
+func (m *resolveSecretReferences) Apply(ctx context.Context, b *bundle.Bundle) error {
+	var undefined []string
+	var secrets []resolvedSecret
+
+	err := b.Config.Mutate(func(root dyn.Value) (dyn.Value, error) {
+		// Refuse a plaintext token; it must not be committed with the configuration.
+		token, err := dyn.GetByPath(root, tokenPath)
+		if err == nil {
+			s, ok := token.AsString()
+			if ok && s != "" && !config.SecretReferenceRegex.MatchString(s) {
+				return dyn.InvalidValue, fmt.Errorf("%s must reference a secret in the environment (e.g. ${SECRET:DATABRICKS_TOKEN}) at %s", tokenPath, token.Location())
+			}
+		}
+
+		return dyn.Walk(root, func(p dyn.Path, v dyn.Value) (dyn.Value, error) {
+			s, ok := v.AsString()
+			if !ok || !strings.Contains(s, "${SECRET:") {
+				return v, nil
+			}
+
+			out := config.SecretReferenceRegex.ReplaceAllStringFunc(s, func(ref string) string {
+				name := config.SecretReferenceRegex.FindStringSubmatch(ref)[1]
+				value, ok := env.Lookup(ctx, name)
+				if !ok {
+					undefined = append(undefined, fmt.Sprintf("%s (at %s)", name, v.Location()))
+					return ref
+				}
+				// The path is cloned because the walk reuses its backing array.
+				secrets = append(secrets, resolvedSecret{slices.Clone(p), value})
+				return value
+			})
+
+			return dyn.NewValue(out, v.Location()), nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(undefined) > 0 {
+		return fmt.Errorf("undefined secrets referenced in configuration: %s", strings.Join(undefined, ", "))
+	}
+
+	for _, secret := range secrets {
+		b.Config.AddSecret(secret.path, secret.value)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package mutator_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/libs/env"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSecretReferences(t *testing.T) {
+	header := "Bearer ${SECRET:MY_TOKEN}"
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "name",
+			},
+			Workspace: config.Workspace{
+				Host:  "https://example.cloud.databricks.com",
+				Token: "${SECRET:MY_TOKEN}",
+			},
+			Variables: map[string]*variable.Variable{
+				"header": {
+					Default: &header,
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = env.Set(ctx, "MY_TOKEN", "dapi1234")
+
+	err := bundle.Apply(ctx, b, mutator.ResolveSecretReferences())
+	require.NoError(t, err)
+	assert.Equal(t, config.Secret("dapi1234"), b.Config.Workspace.Token)
+	assert.Equal(t, "Bearer dapi1234", *b.Config.Variables["header"].Default)
+
+	// The resolved secret is redacted when exporting the configuration.
+	buf, err := b.Config.ExportJSON()
+	require.NoError(t, err)
+	assert.NotContains(t, string(buf), "dapi1234")
+	assert.Contains(t, string(buf), `"token": "[REDACTED]"`)
+	assert.Contains(t, string(buf), `"default": "Bearer [REDACTED]"`)
+
+	var out bytes.Buffer
+	err = b.Config.WriteYAML(&out)
+	require.NoError(t, err)
+	assert.NotContains(t, out.String(), "dapi1234")
+	assert.Contains(t, out.String(), "token: '[REDACTED]'")
+}
+
+func TestResolveSecretReferencesUndefined(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				Token: "${SECRET:DOES_NOT_EXIST}",
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ResolveSecretReferences())
+	assert.ErrorContains(t, err, "undefined secrets referenced in configuration: DOES_NOT_EXIST (at ")
+}
+
+func TestResolveSecretReferencesRejectsPlaintextToken(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Workspace: config.Workspace{
+				Token: "dapi1234",
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, mutator.ResolveSecretReferences())
+	assert.ErrorContains(t, err, "workspace.token must reference a secret in the environment")
+}
+
+func TestResolveSecretReferencesRedactsOnlyResolvedFields(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "dapi",
+			},
+			Workspace: config.Workspace{
+				Token: "${SECRET:MY_TOKEN}",
+			},
+		},
+	}
+
+	ctx := env.Set(context.Background(), "MY_TOKEN", "dapi")
+	err := bundle.Apply(ctx, b, mutator.ResolveSecretReferences())
+	require.NoError(t, err)
+
+	// The bundle name happens to equal the secret but did not come from it.
+	buf, err := b.Config.ExportJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(buf), `"token": "[REDACTED]"`)
+	assert.Contains(t, string(buf), `"name": "dapi"`)
+}
+
+func TestResolveSecretReferencesOnlyInSelectedTarget(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Targets: map[string]*config.Target{
+				"dev": {},
+				"prod": {
+					Workspace: &config.Workspace{
+						Token: "${SECRET:DOES_NOT_EXIST}",
+					},
+				},
+			},
+		},
+	}
+
+	m := append([]bundle.Mutator{mutator.SelectTarget("dev")}, mutator.TargetMutators()...)
+	err := bundle.Apply(context.Background(), b, bundle.Seq(m...))
+	require.NoError(t, err)
+	assert.Empty(t, b.Config.Workspace.Token)
+}
@@ -36,6 +36,10 @@ func (m *resolveVariableReferences) Apply(ctx context.Context, b *bundle.Bundle)
 	// We rewrite it here to make the resolution logic simpler.
 	varPath := dyn.NewPath(dyn.Key("var"))
 
+	// The path ${workspace.root} is a shorthand for ${workspace.root_path}.
+	// It is the name used in the documentation of the default workspace paths.
+	workspaceRootPath := dyn.NewPath(dyn.Key("workspace"), dyn.Key("root"))
+
 	return b.Config.Mutate(func(root dyn.Value) (dyn.Value, error) {
 		// Synthesize a copy of the root that has all fields that are present in the type
 		// but not set in the dynamic value set to their corresponding empty value.
@@ -69,6 +73,11 @@ func (m *resolveVariableReferences) Apply(ctx context.Context, b *bundle.Bundle)
 				)
 			}
 
+			// Rewrite the shorthand path ${workspace.root} into ${workspace.root_path}.
+			if path.Equal(workspaceRootPath) {
+				path = dyn.NewPath(dyn.Key("workspace"), dyn.Key("root_path"))
+			}
+
 			// Perform resolution only if the path starts with one of the specified prefixes.
 			for _, prefix := range prefixes {
 				if path.HasPrefix(prefix) {
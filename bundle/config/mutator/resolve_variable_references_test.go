@@ -192,3 +192,52 @@ func TestResolveVariableReferencesForPrimitiveNonStringFields(t *testing.T) {
 	assert.Equal(t, 2, b.Config.Resources.Jobs["job1"].JobSettings.Tasks[0].NewCluster.Autoscale.MaxWorkers)
 	assert.Equal(t, 0.5, b.Config.Resources.Jobs["job1"].JobSettings.Tasks[0].NewCluster.AzureAttributes.SpotBidMaxPrice)
 }
+
+func TestResolveVariableReferencesToWorkspaceFields(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "example",
+			},
+			Workspace: config.Workspace{
+				Host:     "https://example.cloud.databricks.com",
+				RootPath: "/Users/jane@doe.com/.bundle/example",
+				FilePath: "${workspace.root}/files",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {
+						JobSettings: &jobs.JobSettings{
+							Description: "Deployed to ${workspace.host} from ${workspace.file_path}",
+							Tags: map[string]string{
+								"root": "${workspace.root_path}",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, ResolveVariableReferences("workspace"))
+	require.NoError(t, err)
+	assert.Equal(t, "/Users/jane@doe.com/.bundle/example/files", b.Config.Workspace.FilePath)
+	assert.Equal(t, "Deployed to https://example.cloud.databricks.com from /Users/jane@doe.com/.bundle/example/files", b.Config.Resources.Jobs["job1"].Description)
+	assert.Equal(t, "/Users/jane@doe.com/.bundle/example", b.Config.Resources.Jobs["job1"].Tags["root"])
+}
+
+func TestResolveVariableReferencesToUnknownWorkspaceField(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Name: "example",
+			},
+			Workspace: config.Workspace{
+				RootPath: "${workspace.does_not_exist}/bar",
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, ResolveVariableReferences("workspace"))
+	assert.ErrorContains(t, err, "reference does not exist: ${workspace.does_not_exist}")
+}
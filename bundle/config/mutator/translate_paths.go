@@ -11,7 +11,9 @@ import (
 	"strings"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/dyn"
 	"github.com/databricks/cli/libs/notebook"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
 )
 
 type ErrIsNotebook struct {
@@ -30,6 +32,8 @@ func (err ErrIsNotNotebook) Error() string {
 	return fmt.Sprintf("file at %s is not a notebook", err.path)
 }
 
+var errWorkspaceFilePathNotDefined = errors.New("unable to translate paths: workspace file path not defined")
+
 type translatePaths struct {
 	seen map[string]string
 }
@@ -91,11 +95,16 @@ func (m *translatePaths) rewritePath(
 		return fmt.Errorf("path %s is not contained in bundle root path", localPath)
 	}
 
-	// Prefix remote path with its remote root path.
-	remotePath := path.Join(b.Config.Workspace.FilePath, filepath.ToSlash(localRelPath))
+	// Prefix remote path with its remote root path. If the workspace file path is not
+	// defined, the remote path is left empty and translating to it fails.
+	// It is defined by [DefineDefaultWorkspacePaths] if not set explicitly.
+	remotePath := ""
+	if b.Config.Workspace.FilePath != "" {
+		remotePath = path.Join(b.Config.Workspace.FilePath, filepath.ToSlash(localRelPath))
+	}
 
 	// Convert local path into workspace path via specified function.
-	interp, err := fn(*p, localPath, localRelPath, filepath.ToSlash(remotePath))
+	interp, err := fn(*p, localPath, localRelPath, remotePath)
 	if err != nil {
 		return err
 	}
@@ -106,7 +115,18 @@ func (m *translatePaths) rewritePath(
 }
 
 func translateNotebookPath(literal, localFullPath, localRelPath, remotePath string) (string, error) {
-	nb, _, err := notebook.Detect(localFullPath)
+	_, err := detectNotebook(literal, localFullPath)
+	if err != nil {
+		return "", err
+	}
+
+	return notebookRemotePath(localFullPath, remotePath)
+}
+
+// detectNotebook returns the language of the notebook at the specified path.
+// It returns an error if the path doesn't exist or is not a notebook.
+func detectNotebook(literal, localFullPath string) (workspace.Language, error) {
+	nb, language, err := notebook.Detect(localFullPath)
 	if os.IsNotExist(err) {
 		return "", fmt.Errorf("notebook %s not found", literal)
 	}
@@ -116,6 +136,13 @@ func translateNotebookPath(literal, localFullPath, localRelPath, remotePath stri
 	if !nb {
 		return "", ErrIsNotNotebook{localFullPath}
 	}
+	return language, nil
+}
+
+func notebookRemotePath(localFullPath, remotePath string) (string, error) {
+	if remotePath == "" {
+		return "", errWorkspaceFilePathNotDefined
+	}
 
 	// Upon import, notebooks are stripped of their extension.
 	return strings.TrimSuffix(remotePath, filepath.Ext(localFullPath)), nil
@@ -132,17 +159,26 @@ func translateFilePath(literal, localFullPath, localRelPath, remotePath string)
 	if nb {
 		return "", ErrIsNotebook{localFullPath}
 	}
+	if remotePath == "" {
+		return "", errWorkspaceFilePathNotDefined
+	}
 	return remotePath, nil
 }
 
 func translateDirectoryPath(literal, localFullPath, localRelPath, remotePath string) (string, error) {
 	info, err := os.Stat(localFullPath)
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("directory %s not found", literal)
+	}
 	if err != nil {
 		return "", err
 	}
 	if !info.IsDir() {
 		return "", fmt.Errorf("%s is not a directory", localFullPath)
 	}
+	if remotePath == "" {
+		return "", errWorkspaceFilePathNotDefined
+	}
 	return remotePath, nil
 }
 
@@ -163,29 +199,66 @@ type transformer struct {
 
 type transformFunc func(resource any, dir string) *transformer
 
-// Apply all matches transformers for the given resource
-func (m *translatePaths) applyTransformers(funcs []transformFunc, b *bundle.Bundle, resource any, dir string) error {
+// Apply all matches transformers for the given resource.
+// The path p is the path of the resource in the configuration tree.
+// It is used to include the location of the resource in errors.
+func (m *translatePaths) applyTransformers(funcs []transformFunc, b *bundle.Bundle, resource any, dir string, p dyn.Path) error {
 	for _, transformFn := range funcs {
 		transformer := transformFn(resource, dir)
 		if transformer == nil {
 			continue
 		}
 
-		err := m.rewritePath(transformer.dir, b, transformer.path, transformer.fn)
+		err := m.rewritePath(fieldDirectory(b, p, transformer), b, transformer.path, transformer.fn)
 		if err != nil {
 			if target := (&ErrIsNotebook{}); errors.As(err, target) {
-				return fmt.Errorf(`expected a file for "%s" but got a notebook: %w`, transformer.configPath, target)
+				err = fmt.Errorf(`expected a file for "%s" but got a notebook: %w`, transformer.configPath, target)
 			}
 			if target := (&ErrIsNotNotebook{}); errors.As(err, target) {
-				return fmt.Errorf(`expected a notebook for "%s" but got a file: %w`, transformer.configPath, target)
+				err = fmt.Errorf(`expected a notebook for "%s" but got a file: %w`, transformer.configPath, target)
 			}
-			return err
+			return withLocation(b, p, err)
 		}
 	}
 
 	return nil
 }
 
+// fieldDirectory returns the directory of the file that defines the path to transform.
+// A resource may be defined across multiple files, in which case the file that defines
+// the path is not necessarily the file that defines the resource. It falls back to the
+// directory of the resource if the location of the path is not known.
+func fieldDirectory(b *bundle.Bundle, p dyn.Path, t *transformer) string {
+	// The config path is prefixed with the name of the collection the resource is in.
+	_, rest, ok := strings.Cut(t.configPath, ".")
+	if !ok {
+		return t.dir
+	}
+
+	v, err := dyn.GetByPath(b.Config.Value(), p.Join(dyn.MustPathFromString(rest)))
+	if err != nil || v.Location().File == "" {
+		return t.dir
+	}
+
+	return filepath.Dir(v.Location().File)
+}
+
+// withLocation annotates err with the file and line that define the
+// configuration value at path p, if known. The file is relative to the bundle root.
+func withLocation(b *bundle.Bundle, p dyn.Path, err error) error {
+	v, verr := dyn.GetByPath(b.Config.Value(), p)
+	if verr != nil || v.Location().Line == 0 {
+		return err
+	}
+
+	loc := v.Location()
+	file := loc.File
+	if rel, rerr := filepath.Rel(b.Config.Path, file); rerr == nil {
+		file = rel
+	}
+	return fmt.Errorf("%w (defined at %s:%d)", err, filepath.ToSlash(file), loc.Line)
+}
+
 func (m *translatePaths) Apply(_ context.Context, b *bundle.Bundle) error {
 	m.seen = make(map[string]string)
 
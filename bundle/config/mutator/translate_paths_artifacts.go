@@ -5,6 +5,7 @@ import (
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/dyn"
 )
 
 func transformArtifactPath(resource any, dir string) *transformer {
@@ -32,7 +33,8 @@ func applyArtifactTransformers(m *translatePaths, b *bundle.Bundle) error {
 			return fmt.Errorf("unable to determine directory for artifact %s: %w", key, err)
 		}
 
-		err = m.applyTransformers(artifactTransformers, b, artifact, dir)
+		artifactPath := dyn.NewPath(dyn.Key("artifacts"), dyn.Key(key))
+		err = m.applyTransformers(artifactTransformers, b, artifact, dir, artifactPath)
 		if err != nil {
 			return err
 		}
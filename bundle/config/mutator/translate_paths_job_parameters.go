@@ -0,0 +1,98 @@
+package mutator
+
+import (
+	"regexp"
+	"slices"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"golang.org/x/exp/maps"
+)
+
+// fileReferenceRegex matches references to local files in job parameters,
+// for example `${file:./config/settings.json}`.
+var fileReferenceRegex = regexp.MustCompile(`\$\{file:([^}]+)\}`)
+
+// translateFileReferences replaces all file references in the string at p
+// with the path of the referenced file in the workspace.
+func (m *translatePaths) translateFileReferences(b *bundle.Bundle, dir string, p dyn.Path, s *string) error {
+	var err error
+	*s = fileReferenceRegex.ReplaceAllStringFunc(*s, func(match string) string {
+		if err != nil {
+			return match
+		}
+		out := fileReferenceRegex.FindStringSubmatch(match)[1]
+		if rerr := m.rewritePath(dir, b, &out, translateFilePath); rerr != nil {
+			err = withLocation(b, p, rerr)
+			return match
+		}
+		return out
+	})
+	return err
+}
+
+func (m *translatePaths) translateFileReferencesInMap(b *bundle.Bundle, dir string, p dyn.Path, params map[string]string) error {
+	// Iterate in a stable order such that errors are deterministic.
+	keys := maps.Keys(params)
+	slices.Sort(keys)
+	for _, k := range keys {
+		v := params[k]
+		if err := m.translateFileReferences(b, dir, p.Append(dyn.Key(k)), &v); err != nil {
+			return err
+		}
+		params[k] = v
+	}
+	return nil
+}
+
+func (m *translatePaths) translateFileReferencesInSlice(b *bundle.Bundle, dir string, p dyn.Path, params []string) error {
+	for i := range params {
+		if err := m.translateFileReferences(b, dir, p.Append(dyn.Index(i)), &params[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyJobParameterTransformers translates file references in the default values
+// of job parameters and in the parameters of notebook, Python, and wheel tasks.
+func (m *translatePaths) applyJobParameterTransformers(b *bundle.Bundle, dir string, jobPath dyn.Path, job *jobs.JobSettings) error {
+	for i := range job.Parameters {
+		p := jobPath.Append(dyn.Key("parameters"), dyn.Index(i), dyn.Key("default"))
+		if err := m.translateFileReferences(b, dir, p, &job.Parameters[i].Default); err != nil {
+			return err
+		}
+	}
+
+	for i := range job.Tasks {
+		task := &job.Tasks[i]
+		taskPath := jobPath.Append(dyn.Key("tasks"), dyn.Index(i))
+
+		if task.NotebookTask != nil {
+			p := taskPath.Append(dyn.Key("notebook_task"), dyn.Key("base_parameters"))
+			if err := m.translateFileReferencesInMap(b, dir, p, task.NotebookTask.BaseParameters); err != nil {
+				return err
+			}
+		}
+
+		if task.SparkPythonTask != nil {
+			p := taskPath.Append(dyn.Key("spark_python_task"), dyn.Key("parameters"))
+			if err := m.translateFileReferencesInSlice(b, dir, p, task.SparkPythonTask.Parameters); err != nil {
+				return err
+			}
+		}
+
+		if task.PythonWheelTask != nil {
+			p := taskPath.Append(dyn.Key("python_wheel_task"))
+			if err := m.translateFileReferencesInSlice(b, dir, p.Append(dyn.Key("parameters")), task.PythonWheelTask.Parameters); err != nil {
+				return err
+			}
+			if err := m.translateFileReferencesInMap(b, dir, p.Append(dyn.Key("named_parameters")), task.PythonWheelTask.NamedParameters); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/dyn"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 )
@@ -92,12 +93,27 @@ func transformJarLibrary(resource any, dir string) *transformer {
 	}
 }
 
+func transformEggLibrary(resource any, dir string) *transformer {
+	library, ok := resource.(*compute.Library)
+	if !ok || library.Egg == "" {
+		return nil
+	}
+
+	return &transformer{
+		dir,
+		&library.Egg,
+		"libraries.egg",
+		translateNoOp, // Does not convert to remote path but makes sure that nested paths resolved correctly
+	}
+}
+
 func applyJobTransformers(m *translatePaths, b *bundle.Bundle) error {
 	jobTransformers := []transformFunc{
 		transformNotebookTask,
 		transformSparkTask,
 		transformWhlLibrary,
 		transformJarLibrary,
+		transformEggLibrary,
 		transformDbtTask,
 		transformSqlFileTask,
 	}
@@ -108,6 +124,13 @@ func applyJobTransformers(m *translatePaths, b *bundle.Bundle) error {
 			return fmt.Errorf("unable to determine directory for job %s: %w", key, err)
 		}
 
+		// File references in parameters always refer to local files.
+		jobPath := dyn.NewPath(dyn.Key("resources"), dyn.Key("jobs"), dyn.Key(key))
+		err = m.applyJobParameterTransformers(b, dir, jobPath, job.JobSettings)
+		if err != nil {
+			return err
+		}
+
 		// Do not translate job task paths if using git source
 		if job.GitSource != nil {
 			continue
@@ -115,13 +138,17 @@ func applyJobTransformers(m *translatePaths, b *bundle.Bundle) error {
 
 		for i := 0; i < len(job.Tasks); i++ {
 			task := &job.Tasks[i]
-			err := m.applyTransformers(jobTransformers, b, task, dir)
+			taskPath := jobPath.Append(dyn.Key("tasks"), dyn.Index(i))
+			err := m.applyTransformers(jobTransformers, b, task, dir, taskPath)
 			if err != nil {
 				return err
 			}
+			// Libraries are only attached to tasks. Cluster specifications
+			// (new_cluster and job_clusters) do not include libraries.
 			for j := 0; j < len(task.Libraries); j++ {
 				library := &task.Libraries[j]
-				err := m.applyTransformers(jobTransformers, b, library, dir)
+				libraryPath := taskPath.Append(dyn.Key("libraries"), dyn.Index(j))
+				err := m.applyTransformers(jobTransformers, b, library, dir, libraryPath)
 				if err != nil {
 					return err
 				}
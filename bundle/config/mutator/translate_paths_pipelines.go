@@ -2,9 +2,12 @@ package mutator
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/dyn"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
 )
 
 func transformLibraryNotebook(resource any, dir string) *transformer {
@@ -17,10 +20,25 @@ func transformLibraryNotebook(resource any, dir string) *transformer {
 		dir,
 		&library.Notebook.Path,
 		"libraries.notebook.path",
-		translateNotebookPath,
+		translatePipelineNotebookPath,
 	}
 }
 
+// translatePipelineNotebookPath translates the path of a notebook library.
+// Pipelines only run Python and SQL notebooks, so notebooks in other languages
+// are rejected here instead of failing when the pipeline runs.
+func translatePipelineNotebookPath(literal, localFullPath, localRelPath, remotePath string) (string, error) {
+	language, err := detectNotebook(literal, localFullPath)
+	if err != nil {
+		return "", err
+	}
+	if language != workspace.LanguagePython && language != workspace.LanguageSql {
+		return "", fmt.Errorf("notebook %s is written in %s; pipelines only support Python and SQL notebooks", literal, strings.ToLower(string(language)))
+	}
+
+	return notebookRemotePath(localFullPath, remotePath)
+}
+
 func transformLibraryFile(resource any, dir string) *transformer {
 	library, ok := resource.(*pipelines.PipelineLibrary)
 	if !ok || library.File == nil {
@@ -49,7 +67,8 @@ func applyPipelineTransformers(m *translatePaths, b *bundle.Bundle) error {
 
 		for i := 0; i < len(pipeline.Libraries); i++ {
 			library := &pipeline.Libraries[i]
-			err := m.applyTransformers(pipelineTransformers, b, library, dir)
+			libraryPath := dyn.NewPath(dyn.Key("resources"), dyn.Key("pipelines"), dyn.Key(key), dyn.Key("libraries"), dyn.Index(i))
+			err := m.applyTransformers(pipelineTransformers, b, library, dir, libraryPath)
 			if err != nil {
 				return err
 			}
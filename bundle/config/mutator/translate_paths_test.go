@@ -294,6 +294,7 @@ func TestTranslatePathsInSubdirectories(t *testing.T) {
 									},
 									Libraries: []compute.Library{
 										{Jar: "./dist/task.jar"},
+										{Egg: "./dist/task.egg"},
 									},
 								},
 								{
@@ -345,6 +346,11 @@ func TestTranslatePathsInSubdirectories(t *testing.T) {
 		filepath.Join("job", "dist", "task.jar"),
 		b.Config.Resources.Jobs["job"].Tasks[1].Libraries[0].Jar,
 	)
+	assert.Equal(
+		t,
+		filepath.Join("job", "dist", "task.egg"),
+		b.Config.Resources.Jobs["job"].Tasks[1].Libraries[1].Egg,
+	)
 	assert.Equal(
 		t,
 		"/bundle/job/my_sql_file.sql",
@@ -363,6 +369,59 @@ func TestTranslatePathsInSubdirectories(t *testing.T) {
 	)
 }
 
+func TestTranslatePathsInResourceDefinedInMultipleFiles(t *testing.T) {
+	dir := t.TempDir()
+	touchEmptyFile(t, filepath.Join(dir, "base", "my_python_file.py"))
+	touchEmptyFile(t, filepath.Join(dir, "project", "my_python_file.py"))
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Workspace: config.Workspace{
+				FilePath: "/bundle",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									SparkPythonTask: &jobs.SparkPythonTask{
+										PythonFile: "./my_python_file.py",
+									},
+								},
+								{
+									SparkPythonTask: &jobs.SparkPythonTask{
+										PythonFile: "./my_python_file.py",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// The job is defined in the base configuration and its second task in the project configuration.
+	bundletest.SetLocation(b, "resources.jobs", filepath.Join(dir, "base/databricks.yml"))
+	bundletest.SetLocation(b, "resources.jobs.job.tasks[1]", filepath.Join(dir, "project/databricks.yml"))
+
+	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		"/bundle/base/my_python_file.py",
+		b.Config.Resources.Jobs["job"].Tasks[0].SparkPythonTask.PythonFile,
+	)
+	assert.Equal(
+		t,
+		"/bundle/project/my_python_file.py",
+		b.Config.Resources.Jobs["job"].Tasks[1].SparkPythonTask.PythonFile,
+	)
+}
+
 func TestTranslatePathsOutsideBundleRoot(t *testing.T) {
 	dir := t.TempDir()
 
@@ -456,6 +515,83 @@ func TestJobFileDoesNotExistError(t *testing.T) {
 	assert.EqualError(t, err, "file ./doesnt_exist.py not found")
 }
 
+func TestJobSqlFileDoesNotExistError(t *testing.T) {
+	dir := t.TempDir()
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Workspace: config.Workspace{
+				FilePath: "/bundle",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									SqlTask: &jobs.SqlTask{
+										File: &jobs.SqlTaskFile{
+											Path: "./doesnt_exist.sql",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, ".", filepath.Join(dir, "fake.yml"))
+
+	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	assert.EqualError(t, err, "file ./doesnt_exist.sql not found")
+}
+
+func TestJobDbtProjectDirectoryErrors(t *testing.T) {
+	dir := t.TempDir()
+	touchEmptyFile(t, filepath.Join(dir, "dbt_project.yml"))
+
+	for _, tc := range []struct {
+		path string
+		err  string
+	}{
+		{"./doesnt_exist", "directory ./doesnt_exist not found"},
+		{"./dbt_project.yml", filepath.Join(dir, "dbt_project.yml") + " is not a directory"},
+	} {
+		b := &bundle.Bundle{
+			Config: config.Root{
+				Path: dir,
+				Workspace: config.Workspace{
+					FilePath: "/bundle",
+				},
+				Resources: config.Resources{
+					Jobs: map[string]*resources.Job{
+						"job": {
+							JobSettings: &jobs.JobSettings{
+								Tasks: []jobs.Task{
+									{
+										DbtTask: &jobs.DbtTask{
+											ProjectDirectory: tc.path,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		bundletest.SetLocation(b, ".", filepath.Join(dir, "fake.yml"))
+
+		err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+		assert.EqualError(t, err, tc.err)
+	}
+}
+
 func TestPipelineNotebookDoesNotExistError(t *testing.T) {
 	dir := t.TempDir()
 
@@ -486,6 +622,41 @@ func TestPipelineNotebookDoesNotExistError(t *testing.T) {
 	assert.EqualError(t, err, "notebook ./doesnt_exist.py not found")
 }
 
+func TestPipelineNotebookLanguageError(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "my_notebook.scala"), []byte("// Databricks notebook source\n"), 0644)
+	require.NoError(t, err)
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Workspace: config.Workspace{
+				FilePath: "/bundle",
+			},
+			Resources: config.Resources{
+				Pipelines: map[string]*resources.Pipeline{
+					"pipeline": {
+						PipelineSpec: &pipelines.PipelineSpec{
+							Libraries: []pipelines.PipelineLibrary{
+								{
+									Notebook: &pipelines.NotebookLibrary{
+										Path: "./my_notebook.scala",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, ".", filepath.Join(dir, "fake.yml"))
+
+	err = bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	assert.EqualError(t, err, "notebook ./my_notebook.scala is written in scala; pipelines only support Python and SQL notebooks")
+}
+
 func TestPipelineFileDoesNotExistError(t *testing.T) {
 	dir := t.TempDir()
 
@@ -651,3 +822,172 @@ func TestPipelineFileLibraryWithNotebookSourceError(t *testing.T) {
 	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
 	assert.ErrorContains(t, err, `expected a file for "libraries.file.path" but got a notebook`)
 }
+
+func TestTranslatePathsWithoutWorkspaceFilePath(t *testing.T) {
+	dir := t.TempDir()
+	touchNotebookFile(t, filepath.Join(dir, "my_job_notebook.py"))
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									NotebookTask: &jobs.NotebookTask{
+										NotebookPath: "./my_job_notebook.py",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, ".", filepath.Join(dir, "resource.yml"))
+
+	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	assert.ErrorContains(t, err, "unable to translate paths: workspace file path not defined")
+}
+
+func TestTranslatePathsWithoutWorkspaceFilePathForLocalPaths(t *testing.T) {
+	dir := t.TempDir()
+	touchEmptyFile(t, filepath.Join(dir, "dist", "task.whl"))
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Artifacts: config.Artifacts{
+				"whl": &config.Artifact{
+					Path: "./dist",
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									Libraries: []compute.Library{
+										{Whl: "./dist/task.whl"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, ".", filepath.Join(dir, "resource.yml"))
+
+	// Paths that remain local do not require the workspace file path.
+	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	require.NoError(t, err)
+	assert.Equal(t, "dist", b.Config.Artifacts["whl"].Path)
+	assert.Equal(t, filepath.Join("dist", "task.whl"), b.Config.Resources.Jobs["job"].Tasks[0].Libraries[0].Whl)
+}
+
+func TestTranslateFileReferencesInJobParameters(t *testing.T) {
+	dir := t.TempDir()
+	touchEmptyFile(t, filepath.Join(dir, "config", "settings.json"))
+	touchEmptyFile(t, filepath.Join(dir, "config", "other.json"))
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Workspace: config.Workspace{
+				FilePath: "/bundle",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						JobSettings: &jobs.JobSettings{
+							Parameters: []jobs.JobParameterDefinition{
+								{Name: "settings", Default: "${file:./config/settings.json}"},
+								{Name: "plain", Default: "./config/settings.json"},
+							},
+							Tasks: []jobs.Task{
+								{
+									NotebookTask: &jobs.NotebookTask{
+										NotebookPath: "/Workspace/notebook",
+										BaseParameters: map[string]string{
+											"settings": "${file:./config/settings.json}",
+											"absolute": "${file:/Workspace/settings.json}",
+										},
+									},
+								},
+								{
+									SparkPythonTask: &jobs.SparkPythonTask{
+										PythonFile: "/Workspace/main.py",
+										Parameters: []string{
+											"--settings=${file:./config/settings.json},${file:./config/other.json}",
+										},
+									},
+								},
+								{
+									PythonWheelTask: &jobs.PythonWheelTask{
+										Parameters:      []string{"${file:config/other.json}"},
+										NamedParameters: map[string]string{"settings": "${file:./config/settings.json}"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, ".", filepath.Join(dir, "resource.yml"))
+
+	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	require.NoError(t, err)
+
+	job := b.Config.Resources.Jobs["job"]
+	assert.Equal(t, "/bundle/config/settings.json", job.Parameters[0].Default)
+	assert.Equal(t, "./config/settings.json", job.Parameters[1].Default)
+	assert.Equal(t, map[string]string{
+		"settings": "/bundle/config/settings.json",
+		"absolute": "/Workspace/settings.json",
+	}, job.Tasks[0].NotebookTask.BaseParameters)
+	assert.Equal(t, []string{
+		"--settings=/bundle/config/settings.json,/bundle/config/other.json",
+	}, job.Tasks[1].SparkPythonTask.Parameters)
+	assert.Equal(t, []string{"/bundle/config/other.json"}, job.Tasks[2].PythonWheelTask.Parameters)
+	assert.Equal(t, map[string]string{"settings": "/bundle/config/settings.json"}, job.Tasks[2].PythonWheelTask.NamedParameters)
+}
+
+func TestTranslateFileReferencesInJobParametersFileDoesNotExistError(t *testing.T) {
+	dir := t.TempDir()
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Workspace: config.Workspace{
+				FilePath: "/bundle",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						JobSettings: &jobs.JobSettings{
+							Parameters: []jobs.JobParameterDefinition{
+								{Name: "settings", Default: "${file:./doesnt_exist.json}"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	bundletest.SetLocation(b, ".", filepath.Join(dir, "resource.yml"))
+
+	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	assert.EqualError(t, err, "file ./doesnt_exist.json not found")
+}
@@ -0,0 +1,29 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+)
+
+type validateDeploymentBackend struct{}
+
+// ValidateDeploymentBackend returns an error if an unknown deployment backend is configured.
+func ValidateDeploymentBackend() bundle.Mutator {
+	return &validateDeploymentBackend{}
+}
+
+func (m *validateDeploymentBackend) Name() string {
+	return "ValidateDeploymentBackend"
+}
+
+func (m *validateDeploymentBackend) Apply(ctx context.Context, b *bundle.Bundle) error {
+	switch backend := b.Config.Bundle.Deployment.Backend; backend {
+	case "", config.DeploymentBackendTerraform, config.DeploymentBackendDirect:
+		return nil
+	default:
+		return fmt.Errorf("unsupported deployment backend %q: must be one of %s, %s", backend, config.DeploymentBackendTerraform, config.DeploymentBackendDirect)
+	}
+}
@@ -0,0 +1,35 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDeploymentBackend(t *testing.T) {
+	for _, backend := range []string{"", "terraform", "direct"} {
+		b := &bundle.Bundle{
+			Config: config.Root{
+				Bundle: config.Bundle{
+					Deployment: config.Deployment{Backend: backend},
+				},
+			},
+		}
+		err := bundle.Apply(context.Background(), b, mutator.ValidateDeploymentBackend())
+		assert.NoError(t, err, backend)
+	}
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Deployment: config.Deployment{Backend: "pulumi"},
+			},
+		},
+	}
+	err := bundle.Apply(context.Background(), b, mutator.ValidateDeploymentBackend())
+	assert.EqualError(t, err, `unsupported deployment backend "pulumi": must be one of terraform, direct`)
+}
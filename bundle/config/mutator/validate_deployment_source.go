@@ -0,0 +1,29 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+)
+
+type validateDeploymentSource struct{}
+
+// ValidateDeploymentSource returns an error if an unknown deployment source is configured.
+func ValidateDeploymentSource() bundle.Mutator {
+	return &validateDeploymentSource{}
+}
+
+func (m *validateDeploymentSource) Name() string {
+	return "ValidateDeploymentSource"
+}
+
+func (m *validateDeploymentSource) Apply(ctx context.Context, b *bundle.Bundle) error {
+	switch source := b.Config.Bundle.Deployment.Source; source {
+	case "", config.DeploymentSourceFiles, config.DeploymentSourceRepo:
+		return nil
+	default:
+		return fmt.Errorf("unsupported deployment source %q: must be one of %s, %s", source, config.DeploymentSourceFiles, config.DeploymentSourceRepo)
+	}
+}
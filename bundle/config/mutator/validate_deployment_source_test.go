@@ -0,0 +1,35 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDeploymentSource(t *testing.T) {
+	for _, source := range []string{"", "files", "repo"} {
+		b := &bundle.Bundle{
+			Config: config.Root{
+				Bundle: config.Bundle{
+					Deployment: config.Deployment{Source: source},
+				},
+			},
+		}
+		err := bundle.Apply(context.Background(), b, mutator.ValidateDeploymentSource())
+		assert.NoError(t, err, source)
+	}
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Deployment: config.Deployment{Source: "workspace"},
+			},
+		},
+	}
+	err := bundle.Apply(context.Background(), b, mutator.ValidateDeploymentSource())
+	assert.EqualError(t, err, `unsupported deployment source "workspace": must be one of files, repo`)
+}
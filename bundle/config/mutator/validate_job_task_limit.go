@@ -0,0 +1,48 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/databricks/cli/bundle"
+	"golang.org/x/exp/maps"
+)
+
+// The maximum number of tasks a single job may define.
+const MaxTasksPerJob = 100
+
+type validateJobTaskLimit struct {
+	limit int
+}
+
+// ValidateJobTaskLimit checks that no job defines more tasks than the platform allows.
+func ValidateJobTaskLimit() *validateJobTaskLimit {
+	return ValidateJobTaskLimitWithMax(MaxTasksPerJob)
+}
+
+// ValidateJobTaskLimitWithMax checks that no job defines more than limit tasks.
+func ValidateJobTaskLimitWithMax(limit int) *validateJobTaskLimit {
+	return &validateJobTaskLimit{limit: limit}
+}
+
+func (m *validateJobTaskLimit) Name() string {
+	return "ValidateJobTaskLimit"
+}
+
+func (m *validateJobTaskLimit) Apply(ctx context.Context, b *bundle.Bundle) error {
+	// Iterate in a stable order so the same job is reported on every run.
+	keys := maps.Keys(b.Config.Resources.Jobs)
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		job := b.Config.Resources.Jobs[key]
+		if job == nil || job.JobSettings == nil {
+			continue
+		}
+		if n := len(job.Tasks); n > m.limit {
+			return fmt.Errorf("job %s has %d tasks, which exceeds the limit of %d tasks per job", key, n, m.limit)
+		}
+	}
+	return nil
+}
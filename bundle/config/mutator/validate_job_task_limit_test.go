@@ -0,0 +1,56 @@
+package mutator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+)
+
+func bundleWithJobTasks(n int) *bundle.Bundle {
+	tasks := make([]jobs.Task, n)
+	for i := range tasks {
+		tasks[i].TaskKey = fmt.Sprintf("task_%d", i)
+	}
+	return &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"foo": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: tasks,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateJobTaskLimitAtLimit(t *testing.T) {
+	b := bundleWithJobTasks(mutator.MaxTasksPerJob)
+	err := bundle.Apply(context.Background(), b, mutator.ValidateJobTaskLimit())
+	assert.NoError(t, err)
+}
+
+func TestValidateJobTaskLimitAboveLimit(t *testing.T) {
+	b := bundleWithJobTasks(mutator.MaxTasksPerJob + 1)
+	err := bundle.Apply(context.Background(), b, mutator.ValidateJobTaskLimit())
+	assert.EqualError(t, err, "job foo has 101 tasks, which exceeds the limit of 100 tasks per job")
+}
+
+func TestValidateJobTaskLimitWithMax(t *testing.T) {
+	b := bundleWithJobTasks(3)
+
+	err := bundle.Apply(context.Background(), b, mutator.ValidateJobTaskLimitWithMax(3))
+	assert.NoError(t, err)
+
+	err = bundle.Apply(context.Background(), b, mutator.ValidateJobTaskLimitWithMax(2))
+	assert.EqualError(t, err, "job foo has 3 tasks, which exceeds the limit of 2 tasks per job")
+}
@@ -0,0 +1,28 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+)
+
+type validateResourceDependencies struct{}
+
+// ValidateResourceDependencies returns a mutator that fails if resources refer to
+// each other in a cycle, in which case there is no order to deploy them in.
+func ValidateResourceDependencies() bundle.Mutator {
+	return &validateResourceDependencies{}
+}
+
+func (m *validateResourceDependencies) Name() string {
+	return "ValidateResourceDependencies"
+}
+
+func (m *validateResourceDependencies) Apply(ctx context.Context, b *bundle.Bundle) error {
+	_, err := b.Config.DependencyGraph()
+	if err != nil {
+		return fmt.Errorf("cannot determine the order to deploy resources in: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockResourceDependencies(pipelineCatalog string) *bundle.Bundle {
+	return &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"refresh": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									TaskKey: "pipeline",
+									PipelineTask: &jobs.PipelineTask{
+										PipelineId: "${resources.pipelines.ingest.id}",
+									},
+								},
+							},
+						},
+					},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"ingest": {
+						PipelineSpec: &pipelines.PipelineSpec{
+							Catalog: pipelineCatalog,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateResourceDependencies(t *testing.T) {
+	b := mockResourceDependencies("main")
+	err := bundle.Apply(context.Background(), b, mutator.ValidateResourceDependencies())
+	assert.NoError(t, err)
+}
+
+func TestValidateResourceDependenciesCycle(t *testing.T) {
+	b := mockResourceDependencies("${resources.jobs.refresh.name}")
+	err := bundle.Apply(context.Background(), b, mutator.ValidateResourceDependencies())
+	assert.EqualError(t, err, "cannot determine the order to deploy resources in: dependency cycle detected: resources.jobs.refresh -> resources.pipelines.ingest -> resources.jobs.refresh")
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"path"
+	"strings"
+)
+
+// PathScheme identifies the file system a [PathLike] refers to.
+type PathScheme string
+
+const (
+	// PathSchemeWorkspace is used for paths without a scheme.
+	// These refer to the workspace file system.
+	PathSchemeWorkspace PathScheme = ""
+
+	// PathSchemeDbfs is used for paths prefixed with "dbfs:".
+	PathSchemeDbfs PathScheme = "dbfs"
+
+	// PathSchemeS3 is used for paths prefixed with "s3:".
+	PathSchemeS3 PathScheme = "s3"
+)
+
+// PathLike is a remote path that is optionally prefixed with a scheme.
+//
+// Paths without a scheme refer to the workspace file system (e.g. `/Users/jane@doe.com/.bundle`).
+// Paths on DBFS are written as `dbfs:/some/path` and paths on S3 as `s3://bucket/some/path`.
+// The value is stored and serialized verbatim so that it round trips through configuration.
+type PathLike string
+
+// Scheme returns the scheme of this path.
+func (p PathLike) Scheme() PathScheme {
+	scheme, _, ok := strings.Cut(string(p), ":")
+	if !ok {
+		return PathSchemeWorkspace
+	}
+
+	switch PathScheme(strings.ToLower(scheme)) {
+	case PathSchemeDbfs:
+		return PathSchemeDbfs
+	case PathSchemeS3:
+		return PathSchemeS3
+	default:
+		return PathSchemeWorkspace
+	}
+}
+
+// IsWorkspace returns true if this path refers to the workspace file system.
+func (p PathLike) IsWorkspace() bool {
+	return p.Scheme() == PathSchemeWorkspace
+}
+
+// Path returns the path component without its scheme.
+//
+// For DBFS paths this is the absolute path on DBFS (`dbfs:/foo` yields `/foo`).
+// For S3 paths this includes the bucket name (`s3://bucket/foo` yields `bucket/foo`).
+func (p PathLike) Path() string {
+	switch p.Scheme() {
+	case PathSchemeDbfs:
+		return path.Clean("/" + strings.TrimLeft(string(p)[len("dbfs:"):], "/"))
+	case PathSchemeS3:
+		return strings.TrimLeft(string(p)[len("s3:"):], "/")
+	default:
+		return string(p)
+	}
+}
+
+// Join joins any number of path elements to this path and retains its scheme.
+func (p PathLike) Join(elem ...string) PathLike {
+	joined := path.Join(append([]string{p.Path()}, elem...)...)
+	switch p.Scheme() {
+	case PathSchemeDbfs:
+		return PathLike("dbfs:" + joined)
+	case PathSchemeS3:
+		return PathLike("s3://" + joined)
+	default:
+		return PathLike(joined)
+	}
+}
+
+// String returns the path including its scheme.
+func (p PathLike) String() string {
+	return string(p)
+}
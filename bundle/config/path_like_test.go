@@ -0,0 +1,76 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/convert"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pathLikeContainer struct {
+	Path PathLike `json:"path"`
+}
+
+func TestPathLikeScheme(t *testing.T) {
+	assert.Equal(t, PathSchemeWorkspace, PathLike("/Users/jane@doe.com/.bundle").Scheme())
+	assert.Equal(t, PathSchemeWorkspace, PathLike("relative/path").Scheme())
+	assert.Equal(t, PathSchemeDbfs, PathLike("dbfs:/some/path").Scheme())
+	assert.Equal(t, PathSchemeDbfs, PathLike("DBFS:/some/path").Scheme())
+	assert.Equal(t, PathSchemeS3, PathLike("s3://bucket/some/path").Scheme())
+	assert.Equal(t, PathSchemeWorkspace, PathLike("abfss://container@account/path").Scheme())
+}
+
+func TestPathLikePath(t *testing.T) {
+	assert.Equal(t, "/Users/jane@doe.com/.bundle", PathLike("/Users/jane@doe.com/.bundle").Path())
+	assert.Equal(t, "/some/path", PathLike("dbfs:/some/path").Path())
+	assert.Equal(t, "/some/path", PathLike("dbfs:///some/path").Path())
+	assert.Equal(t, "bucket/some/path", PathLike("s3://bucket/some/path").Path())
+}
+
+func TestPathLikeJoin(t *testing.T) {
+	assert.Equal(t, PathLike("/Users/jane@doe.com/.bundle/artifacts"), PathLike("/Users/jane@doe.com/.bundle").Join("artifacts"))
+	assert.Equal(t, PathLike("dbfs:/some/path/artifacts/.internal"), PathLike("dbfs:/some/path").Join("artifacts", ".internal"))
+	assert.Equal(t, PathLike("s3://bucket/some/path/artifacts"), PathLike("s3://bucket/some/path").Join("artifacts"))
+}
+
+func TestPathLikeMarshalJSON(t *testing.T) {
+	for _, path := range []PathLike{
+		"/Users/jane@doe.com/.bundle",
+		"dbfs:/some/path",
+		"s3://bucket/some/path",
+	} {
+		t.Run(path.String(), func(t *testing.T) {
+			buf, err := json.Marshal(pathLikeContainer{Path: path})
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"path":"`+path.String()+`"}`, string(buf))
+
+			var out pathLikeContainer
+			err = json.Unmarshal(buf, &out)
+			require.NoError(t, err)
+			assert.Equal(t, path, out.Path)
+			assert.Equal(t, path.Scheme(), out.Path.Scheme())
+		})
+	}
+}
+
+func TestPathLikeDynamicValueRoundTrip(t *testing.T) {
+	for _, path := range []PathLike{
+		"/Users/jane@doe.com/.bundle",
+		"dbfs:/some/path",
+		"s3://bucket/some/path",
+	} {
+		t.Run(path.String(), func(t *testing.T) {
+			nv, err := convert.FromTyped(pathLikeContainer{Path: path}, dyn.NilValue)
+			require.NoError(t, err)
+			assert.Equal(t, path.String(), nv.Get("path").MustString())
+
+			var out pathLikeContainer
+			err = convert.ToTyped(&out, nv)
+			require.NoError(t, err)
+			assert.Equal(t, path, out.Path)
+		})
+	}
+}
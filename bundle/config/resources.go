@@ -3,9 +3,11 @@ package config
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/databricks/cli/bundle/config/resources"
 	"github.com/databricks/databricks-sdk-go"
+	"golang.org/x/exp/maps"
 )
 
 // Resources defines Databricks resources associated with the bundle.
@@ -34,7 +36,7 @@ func (r *Resources) VerifySafeMerge(other *Resources) error {
 	if err != nil {
 		return err
 	}
-	for k := range otherTracker.Type {
+	for _, k := range sortedKeys(otherTracker.Type) {
 		if _, ok := rootTracker.Type[k]; ok {
 			return fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
 				k,
@@ -48,17 +50,25 @@ func (r *Resources) VerifySafeMerge(other *Resources) error {
 	return nil
 }
 
+// sortedKeys returns the keys of m in sorted order, such that
+// the duplicate that is reported does not depend on map iteration order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := maps.Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
 // This function verifies there are no duplicate names used for the resource definations
 func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker, error) {
 	tracker := &UniqueResourceIdTracker{
 		Type:       make(map[string]string),
 		ConfigPath: make(map[string]string),
 	}
-	for k := range r.Jobs {
+	for _, k := range sortedKeys(r.Jobs) {
 		tracker.Type[k] = "job"
 		tracker.ConfigPath[k] = r.Jobs[k].ConfigFilePath
 	}
-	for k := range r.Pipelines {
+	for _, k := range sortedKeys(r.Pipelines) {
 		if _, ok := tracker.Type[k]; ok {
 			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
 				k,
@@ -71,7 +81,7 @@ func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker,
 		tracker.Type[k] = "pipeline"
 		tracker.ConfigPath[k] = r.Pipelines[k].ConfigFilePath
 	}
-	for k := range r.Models {
+	for _, k := range sortedKeys(r.Models) {
 		if _, ok := tracker.Type[k]; ok {
 			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
 				k,
@@ -84,7 +94,7 @@ func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker,
 		tracker.Type[k] = "mlflow_model"
 		tracker.ConfigPath[k] = r.Models[k].ConfigFilePath
 	}
-	for k := range r.Experiments {
+	for _, k := range sortedKeys(r.Experiments) {
 		if _, ok := tracker.Type[k]; ok {
 			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
 				k,
@@ -97,7 +107,7 @@ func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker,
 		tracker.Type[k] = "mlflow_experiment"
 		tracker.ConfigPath[k] = r.Experiments[k].ConfigFilePath
 	}
-	for k := range r.ModelServingEndpoints {
+	for _, k := range sortedKeys(r.ModelServingEndpoints) {
 		if _, ok := tracker.Type[k]; ok {
 			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
 				k,
@@ -110,7 +120,7 @@ func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker,
 		tracker.Type[k] = "model_serving_endpoint"
 		tracker.ConfigPath[k] = r.ModelServingEndpoints[k].ConfigFilePath
 	}
-	for k := range r.RegisteredModels {
+	for _, k := range sortedKeys(r.RegisteredModels) {
 		if _, ok := tracker.Type[k]; ok {
 			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
 				k,
@@ -0,0 +1,19 @@
+package resources
+
+import "slices"
+
+// Condition restricts the targets a resource is deployed to.
+type Condition struct {
+	// Names of the targets to deploy the resource to.
+	// If empty, the resource is deployed to all targets.
+	Targets []string `json:"targets,omitempty"`
+}
+
+// Matches returns true if a resource with this condition should be deployed
+// to the specified target. A nil condition matches all targets.
+func (c *Condition) Matches(target string) bool {
+	if c == nil || len(c.Targets) == 0 {
+		return true
+	}
+	return slices.Contains(c.Targets, target)
+}
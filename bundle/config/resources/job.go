@@ -16,6 +16,9 @@ type Job struct {
 	Permissions    []Permission   `json:"permissions,omitempty"`
 	ModifiedStatus ModifiedStatus `json:"modified_status,omitempty" bundle:"internal"`
 
+	// When restricts the targets this resource is deployed to.
+	When *Condition `json:"when,omitempty"`
+
 	paths.Paths
 
 	*jobs.JobSettings
@@ -15,6 +15,9 @@ type Pipeline struct {
 	Permissions    []Permission   `json:"permissions,omitempty"`
 	ModifiedStatus ModifiedStatus `json:"modified_status,omitempty" bundle:"internal"`
 
+	// When restricts the targets this resource is deployed to.
+	When *Condition `json:"when,omitempty"`
+
 	paths.Paths
 
 	*pipelines.PipelineSpec
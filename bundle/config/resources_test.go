@@ -36,6 +36,25 @@ func TestVerifyUniqueResourceIdentifiers(t *testing.T) {
 	assert.ErrorContains(t, err, "multiple resources named foo (job at foo.yml, mlflow_experiment at foo2.yml)")
 }
 
+func TestVerifyUniqueResourceIdentifiersReportsFirstDuplicate(t *testing.T) {
+	r := Resources{
+		Jobs: map[string]*resources.Job{
+			"b": {Paths: paths.Paths{ConfigFilePath: "b.yml"}},
+			"a": {Paths: paths.Paths{ConfigFilePath: "a.yml"}},
+		},
+		Pipelines: map[string]*resources.Pipeline{
+			"b": {Paths: paths.Paths{ConfigFilePath: "b2.yml"}},
+			"a": {Paths: paths.Paths{ConfigFilePath: "a2.yml"}},
+		},
+	}
+
+	// The reported duplicate must not depend on map iteration order.
+	for i := 0; i < 10; i++ {
+		_, err := r.VerifyUniqueResourceIdentifiers()
+		assert.EqualError(t, err, "multiple resources named a (job at a.yml, pipeline at a2.yml)")
+	}
+}
+
 func TestVerifySafeMerge(t *testing.T) {
 	r := Resources{
 		Jobs: map[string]*resources.Job{
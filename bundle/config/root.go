@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/databricks/cli/bundle/config/resources"
@@ -24,17 +25,40 @@ type Root struct {
 	diags diag.Diagnostics
 	depth int
 
+	// Secrets that were resolved into this configuration.
+	// They are redacted from the fields they were resolved into when the
+	// configuration is exported.
+	secrets []resolvedSecret
+
+	// Sequences that were merged when loading this configuration.
+	sequenceMerges []SequenceMerge
+
 	// Path contains the directory path to the root of the bundle.
-	// It is set when loading `databricks.yml`.
+	// It is set when loading `databricks.yml` and is always absolute and cleaned,
+	// such that paths joined against it don't depend on the working directory.
 	Path string `json:"-" bundle:"readonly"`
 
 	// Contains user defined variables
 	Variables map[string]*variable.Variable `json:"variables,omitempty"`
 
+	// Env declares environment variables referenced as `${env.NAME}`,
+	// optionally with a default value or marked as required.
+	Env map[string]*EnvVariable `json:"env,omitempty"`
+
+	// Definitions contains reusable configuration blocks, such as cluster
+	// specifications or task templates. Resources can reference them
+	// by name, for example `${definitions.clusters.small}`.
+	Definitions map[string]any `json:"definitions,omitempty"`
+
 	// Bundle contains details about this bundle, such as its name,
 	// version of the spec (TODO), default cluster, default warehouse, etc.
 	Bundle Bundle `json:"bundle,omitempty"`
 
+	// Extends specifies the path to a base configuration file that this
+	// configuration inherits from. The path is relative to the file it is defined in.
+	// The base configuration is loaded first and this configuration is merged on top of it.
+	Extends string `json:"extends,omitempty"`
+
 	// Include specifies a list of patterns of file names to load and
 	// merge into the this configuration. Only includes defined in the root
 	// `databricks.yml` are processed. Defaults to an empty list.
@@ -74,8 +98,11 @@ type Root struct {
 }
 
 // Load loads the bundle configuration file at the specified path.
+// If the path is relative, it is interpreted relative to the working directory.
 func Load(path string) (*Root, error) {
-	raw, err := os.ReadFile(path)
+	// Use the absolute path such that the root path and the locations
+	// of configuration values don't depend on the working directory.
+	path, err := filepath.Abs(path)
 	if err != nil {
 		return nil, err
 	}
@@ -84,10 +111,10 @@ func Load(path string) (*Root, error) {
 		Path: filepath.Dir(path),
 	}
 
-	// Load configuration tree from YAML.
-	v, err := yamlloader.LoadYAML(path, bytes.NewBuffer(raw))
+	// Load configuration tree from YAML, including the configuration it extends.
+	v, err := loadWithExtends(path, nil, r.mergeOptions()...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load %s: %w", path, err)
+		return nil, err
 	}
 
 	// Rewrite configuration tree where necessary.
@@ -114,6 +141,125 @@ func Load(path string) (*Root, error) {
 	return &r, err
 }
 
+// LoadFromDirectories loads the bundle configuration files found in the specified
+// directories and merges them in order. This can be used to compose a bundle out of
+// shared defaults (e.g. an organization-wide base bundle) and project specifics
+// that live in separate repositories.
+//
+// Precedence is as follows:
+//   - Configuration from later directories takes precedence over earlier ones.
+//   - Maps are merged recursively; scalar values from later directories win.
+//   - Sequences from later directories replace earlier ones, except for the
+//     sequences in [appendSequences] (such as job tasks), which are concatenated.
+//   - A resource defined in multiple directories is merged. Every value keeps the
+//     location of the file that defines it, such that relative paths are resolved
+//     against the directory of the file that defines the path.
+//   - The bundle root path is set to the last directory. This means that
+//     `include` directives are resolved relative to the last directory as well.
+func LoadFromDirectories(dirs ...string) (*Root, error) {
+	if len(dirs) == 0 {
+		return nil, fmt.Errorf("no directories specified")
+	}
+
+	var root *Root
+	for _, dir := range dirs {
+		path, err := FileNames.FindInPath(dir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find bundle configuration in %s: %w", dir, err)
+		}
+
+		other, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if root == nil {
+			root = other
+			continue
+		}
+
+		err = root.mergeOverride(other)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge %s: %w", path, err)
+		}
+	}
+
+	return root, nil
+}
+
+// mergeOverride merges the other configuration into this one, where the other
+// configuration takes precedence. Unlike [Root.Merge], resources may be defined
+// in both configurations. The root path is taken from the other configuration.
+func (r *Root) mergeOverride(other *Root) error {
+	// Merge diagnostics.
+	r.diags = append(r.diags, other.diags...)
+	r.sequenceMerges = append(r.sequenceMerges, other.sequenceMerges...)
+
+	err := r.Mutate(func(root dyn.Value) (dyn.Value, error) {
+		return merge.Merge(root, other.value, r.mergeOptions()...)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.Path = other.Path
+	return nil
+}
+
+// loadWithExtends loads the configuration tree from the file at the specified path.
+// If the configuration specifies a base configuration through the `extends` key,
+// the base configuration is loaded first and the configuration is merged on top of it.
+//
+// The argument `seen` holds the absolute paths of the files that extend the file
+// at the specified path, such that we can detect cycles.
+func loadWithExtends(path string, seen []string, opts ...merge.Option) (dyn.Value, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return dyn.InvalidValue, err
+	}
+
+	if slices.Contains(seen, abs) {
+		return dyn.InvalidValue, fmt.Errorf("cycle detected in extends: %s", strings.Join(append(seen, abs), " -> "))
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return dyn.InvalidValue, err
+	}
+
+	v, err := yamlloader.LoadYAML(path, bytes.NewBuffer(raw))
+	if err != nil {
+		return dyn.InvalidValue, fmt.Errorf("failed to load %s: %w", path, err)
+	}
+
+	ev := v.Get("extends")
+	if ev == dyn.NilValue {
+		return v, nil
+	}
+
+	base, ok := ev.AsString()
+	if !ok || base == "" {
+		return dyn.InvalidValue, fmt.Errorf("failed to load %s: extends must be a path to a configuration file", path)
+	}
+
+	// The path to the base configuration is relative to the file it is defined in.
+	if !filepath.IsAbs(base) {
+		base = filepath.Join(filepath.Dir(path), filepath.FromSlash(base))
+	}
+
+	bv, err := loadWithExtends(base, append(seen, abs), opts...)
+	if err != nil {
+		return dyn.InvalidValue, err
+	}
+
+	out, err := merge.Merge(bv, v, opts...)
+	if err != nil {
+		return dyn.InvalidValue, fmt.Errorf("failed to merge %s into %s: %w", path, base, err)
+	}
+
+	return out, nil
+}
+
 func (r *Root) initializeDynamicValue() error {
 	// Many test cases initialize a config as a Go struct literal.
 	// The value will be invalid and we need to populate it from the typed configuration.
@@ -135,11 +281,15 @@ func (r *Root) updateWithDynamicValue(nv dyn.Value) error {
 	// the configuration equals nil (happens in tests).
 	diags := r.diags
 	depth := r.depth
+	secrets := r.secrets
+	sequenceMerges := r.sequenceMerges
 	path := r.Path
 
 	defer func() {
 		r.diags = diags
 		r.depth = depth
+		r.secrets = secrets
+		r.sequenceMerges = sequenceMerges
 		r.Path = path
 	}()
 
@@ -233,10 +383,42 @@ func (r *Root) MarkMutatorExit(ctx context.Context) error {
 	return nil
 }
 
+// Snapshot returns the dynamic configuration tree including changes made
+// to the typed configuration that haven't been synchronized yet.
+func (r *Root) Snapshot() (dyn.Value, error) {
+	return convert.FromTyped(r, r.value)
+}
+
+// Value returns the dynamic configuration tree of this configuration.
+func (r *Root) Value() dyn.Value {
+	return r.value
+}
+
 func (r *Root) Diagnostics() diag.Diagnostics {
 	return r.diags
 }
 
+// resolvedSecret is the value of a secret and the path of the field it was resolved into.
+type resolvedSecret struct {
+	path  dyn.Path
+	value string
+}
+
+// AddSecret records the value of a secret that was resolved into the field at
+// the specified path, such that it is redacted from this field by
+// [Root.ExportJSON] and [Root.WriteYAML].
+func (r *Root) AddSecret(p dyn.Path, value string) {
+	if value == "" {
+		return
+	}
+	if slices.ContainsFunc(r.secrets, func(s resolvedSecret) bool {
+		return s.path.Equal(p) && s.value == value
+	}) {
+		return
+	}
+	r.secrets = append(r.secrets, resolvedSecret{path: p, value: value})
+}
+
 // SetConfigFilePath configures the path that its configuration
 // was loaded from in configuration leafs that require it.
 func (r *Root) ConfigureConfigFilePath() {
@@ -272,6 +454,7 @@ func (r *Root) InitializeVariables(vars []string) error {
 func (r *Root) Merge(other *Root) error {
 	// Merge diagnostics.
 	r.diags = append(r.diags, other.diags...)
+	r.sequenceMerges = append(r.sequenceMerges, other.sequenceMerges...)
 
 	// Check for safe merge, protecting against duplicate resource identifiers
 	err := r.Resources.VerifySafeMerge(&other.Resources)
@@ -281,32 +464,87 @@ func (r *Root) Merge(other *Root) error {
 
 	// Merge dynamic configuration values.
 	return r.Mutate(func(root dyn.Value) (dyn.Value, error) {
-		return merge.Merge(root, other.value)
+		return merge.Merge(root, other.value, r.mergeOptions()...)
 	})
 }
 
-func mergeField(rv, ov dyn.Value, name string) (dyn.Value, error) {
-	path := dyn.NewPath(dyn.Key(name))
-	reference, _ := dyn.GetByPath(rv, path)
-	override, _ := dyn.GetByPath(ov, path)
+// appendSequences holds patterns of paths to sequences that are concatenated when
+// configuration is merged through `include`, `extends`, or target overrides.
+// All other sequences are replaced by the sequence in the configuration that takes precedence.
+//
+// Job tasks, job clusters, and pipeline clusters are concatenated such that elements
+// with the same key can be merged by the [mutator.MergeJobTasks], [mutator.MergeJobClusters],
+// and [mutator.MergePipelineClusters] mutators.
+var appendSequences = withTargetPrefixes(
+	dyn.NewPattern(dyn.Key("include")),
+	dyn.NewPattern(dyn.Key("sync"), dyn.Key("include")),
+	dyn.NewPattern(dyn.Key("sync"), dyn.Key("exclude")),
+	dyn.NewPattern(dyn.Key("permissions")),
+	dyn.NewPattern(dyn.Key("resources"), dyn.AnyKey(), dyn.AnyKey(), dyn.Key("permissions")),
+	dyn.NewPattern(dyn.Key("resources"), dyn.Key("jobs"), dyn.AnyKey(), dyn.Key("tasks")),
+	dyn.NewPattern(dyn.Key("resources"), dyn.Key("jobs"), dyn.AnyKey(), dyn.Key("job_clusters")),
+	dyn.NewPattern(dyn.Key("resources"), dyn.Key("pipelines"), dyn.AnyKey(), dyn.Key("clusters")),
+)
 
-	// Merge the override into the reference.
-	var out dyn.Value
-	var err error
-	if reference.IsValid() && override.IsValid() {
-		out, err = merge.Merge(reference, override)
-		if err != nil {
-			return dyn.InvalidValue, err
+// withTargetPrefixes returns the specified patterns, as well as the patterns for
+// the same paths in target (and deprecated environment) definitions.
+func withTargetPrefixes(patterns ...dyn.Pattern) []dyn.Pattern {
+	out := slices.Clone(patterns)
+	for _, prefix := range []string{"targets", "environments"} {
+		for _, p := range patterns {
+			out = append(out, append(dyn.NewPattern(dyn.Key(prefix), dyn.AnyKey()), p...))
 		}
-	} else if reference.IsValid() {
-		out = reference
-	} else if override.IsValid() {
-		out = override
-	} else {
+	}
+	return out
+}
+
+// SequenceMerge records the strategy that was used to merge a sequence
+// defined in more than one place in the configuration.
+type SequenceMerge struct {
+	// Path of the sequence.
+	Path dyn.Path
+
+	// Location of the sequence that was merged into the existing one.
+	Location dyn.Location
+
+	// Strategy that was used to merge the sequences.
+	Strategy merge.SequenceStrategy
+}
+
+// SequenceMerges returns the sequences that were merged when loading this configuration.
+func (r *Root) SequenceMerges() []SequenceMerge {
+	return r.sequenceMerges
+}
+
+// mergeOptions returns the options to use when merging configuration trees.
+// Sequences are replaced unless their path matches one of [appendSequences].
+// The strategy for every merged sequence is recorded, such that it can be reported.
+func (r *Root) mergeOptions() []merge.Option {
+	return []merge.Option{
+		merge.WithSequenceStrategy(func(p dyn.Path, a, b dyn.Value) merge.SequenceStrategy {
+			strategy := merge.SequenceReplace
+			if slices.ContainsFunc(appendSequences, func(pattern dyn.Pattern) bool { return pattern.Matches(p) }) {
+				strategy = merge.SequenceAppend
+			}
+			r.sequenceMerges = append(r.sequenceMerges, SequenceMerge{
+				Path:     slices.Clone(p),
+				Location: b.Location(),
+				Strategy: strategy,
+			})
+			return strategy
+		}),
+	}
+}
+
+func (r *Root) mergeField(rv, ov dyn.Value, name string) (dyn.Value, error) {
+	override, _ := dyn.Get(ov, name)
+	if !override.IsValid() {
 		return rv, nil
 	}
 
-	return dyn.SetByPath(rv, path, out)
+	// Merge the override into the root, such that merge semantics
+	// are determined by the path of the value relative to the root.
+	return merge.Merge(rv, dyn.V(map[string]dyn.Value{name: override}), r.mergeOptions()...)
 }
 
 func (r *Root) MergeTargetOverrides(name string) error {
@@ -332,7 +570,7 @@ func (r *Root) MergeTargetOverrides(name string) error {
 		"permissions",
 		"variables",
 	} {
-		if root, err = mergeField(root, target, f); err != nil {
+		if root, err = r.mergeField(root, target, f); err != nil {
 			return err
 		}
 	}
@@ -2,10 +2,17 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/merge"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -30,20 +37,48 @@ func TestRootLoad(t *testing.T) {
 	assert.Equal(t, "basic", root.Bundle.Name)
 }
 
+func TestRootLoadFromRelativePath(t *testing.T) {
+	root, err := Load("../tests/basic/databricks.yml")
+	require.NoError(t, err)
+
+	expected, err := filepath.Abs(filepath.Join("..", "tests", "basic"))
+	require.NoError(t, err)
+	assert.True(t, filepath.IsAbs(root.Path))
+	assert.Equal(t, expected, root.Path)
+}
+
 func TestDuplicateIdOnLoadReturnsError(t *testing.T) {
-	_, err := Load("./testdata/duplicate_resource_names_in_root/databricks.yml")
-	assert.ErrorContains(t, err, "multiple resources named foo (job at ./testdata/duplicate_resource_names_in_root/databricks.yml, pipeline at ./testdata/duplicate_resource_names_in_root/databricks.yml)")
+	path, err := filepath.Abs("./testdata/duplicate_resource_names_in_root/databricks.yml")
+	require.NoError(t, err)
+
+	_, err = Load(path)
+	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s, pipeline at %s)", path, path))
 }
 
 func TestDuplicateIdOnMergeReturnsError(t *testing.T) {
-	root, err := Load("./testdata/duplicate_resource_name_in_subconfiguration/databricks.yml")
+	dir, err := filepath.Abs("./testdata/duplicate_resource_name_in_subconfiguration")
+	require.NoError(t, err)
+
+	root, err := Load(filepath.Join(dir, "databricks.yml"))
 	require.NoError(t, err)
 
-	other, err := Load("./testdata/duplicate_resource_name_in_subconfiguration/resources.yml")
+	other, err := Load(filepath.Join(dir, "resources.yml"))
 	require.NoError(t, err)
 
 	err = root.Merge(other)
-	assert.ErrorContains(t, err, "multiple resources named foo (job at ./testdata/duplicate_resource_name_in_subconfiguration/databricks.yml, pipeline at ./testdata/duplicate_resource_name_in_subconfiguration/resources.yml)")
+	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s, pipeline at %s)", filepath.Join(dir, "databricks.yml"), filepath.Join(dir, "resources.yml")))
+}
+
+func TestLoadWarnsOnUnknownTopLevelKey(t *testing.T) {
+	root, err := Load("./testdata/unknown_top_level_key/databricks.yml")
+	require.NoError(t, err)
+
+	diags := root.Diagnostics()
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "unknown field: resource (did you mean resources?)", diags[0].Summary)
+	assert.Equal(t, filepath.Join(root.Path, "databricks.yml"), diags[0].Location.File)
+	assert.Empty(t, root.Resources.Jobs)
 }
 
 func TestInitializeVariables(t *testing.T) {
@@ -106,6 +141,36 @@ func TestInitializeVariablesUndefinedVariables(t *testing.T) {
 	assert.ErrorContains(t, err, "variable bar has not been defined")
 }
 
+func TestInitializeVariablesWithEnum(t *testing.T) {
+	root := &Root{
+		Variables: map[string]*variable.Variable{
+			"foo": {
+				Description: "a variable called foo",
+				Enum:        []string{"dev", "prod"},
+			},
+		},
+	}
+
+	err := root.InitializeVariables([]string{"foo=prod"})
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", *(root.Variables["foo"].Value))
+}
+
+func TestInitializeVariablesEnumViolation(t *testing.T) {
+	root := &Root{
+		Variables: map[string]*variable.Variable{
+			"foo": {
+				Description: "a variable called foo",
+				Enum:        []string{"dev", "prod"},
+			},
+		},
+	}
+
+	err := root.InitializeVariables([]string{"foo=staging"})
+	assert.ErrorContains(t, err, `failed to assign staging to foo: expected one of dev, prod, got "staging"`)
+	assert.Nil(t, root.Variables["foo"].Value)
+}
+
 func TestRootMergeTargetOverridesWithMode(t *testing.T) {
 	root := &Root{
 		Bundle: Bundle{},
@@ -119,3 +184,281 @@ func TestRootMergeTargetOverridesWithMode(t *testing.T) {
 	require.NoError(t, root.MergeTargetOverrides("development"))
 	assert.Equal(t, Development, root.Bundle.Mode)
 }
+
+func writeBundleConfig(t *testing.T, dir, contents string) {
+	err := os.WriteFile(filepath.Join(dir, "databricks.yml"), []byte(contents), 0644)
+	require.NoError(t, err)
+}
+
+func TestLoadFromDirectories(t *testing.T) {
+	base := t.TempDir()
+	writeBundleConfig(t, base, `
+bundle:
+  name: base
+
+workspace:
+  host: https://base.cloud.databricks.com
+
+resources:
+  jobs:
+    shared:
+      name: shared job
+      max_concurrent_runs: 1
+    overlap:
+      name: base overlap
+      max_concurrent_runs: 1
+`)
+
+	project := t.TempDir()
+	writeBundleConfig(t, project, `
+bundle:
+  name: project
+
+resources:
+  jobs:
+    overlap:
+      name: project overlap
+    project:
+      name: project job
+`)
+
+	root, err := LoadFromDirectories(base, project)
+	require.NoError(t, err)
+
+	// Later directories take precedence.
+	assert.Equal(t, "project", root.Bundle.Name)
+	assert.Equal(t, project, root.Path)
+
+	// Values only defined in earlier directories are retained.
+	assert.Equal(t, "https://base.cloud.databricks.com", root.Workspace.Host)
+
+	// Jobs from both directories are present.
+	require.Len(t, root.Resources.Jobs, 3)
+	assert.Equal(t, "shared job", root.Resources.Jobs["shared"].Name)
+	assert.Equal(t, "project job", root.Resources.Jobs["project"].Name)
+
+	// Overlapping jobs are merged with the later directory winning.
+	assert.Equal(t, "project overlap", root.Resources.Jobs["overlap"].Name)
+	assert.Equal(t, 1, root.Resources.Jobs["overlap"].MaxConcurrentRuns)
+
+	// Resources are located where they are first defined.
+	assert.Equal(t, filepath.Join(base, "databricks.yml"), root.Resources.Jobs["shared"].ConfigFilePath)
+	assert.Equal(t, filepath.Join(base, "databricks.yml"), root.Resources.Jobs["overlap"].ConfigFilePath)
+	assert.Equal(t, filepath.Join(project, "databricks.yml"), root.Resources.Jobs["project"].ConfigFilePath)
+
+	// Fields of merged resources keep the location of the file that defines them.
+	name, err := dyn.Get(root.Value(), "resources.jobs.overlap.name")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(project, "databricks.yml"), name.Location().File)
+	runs, err := dyn.Get(root.Value(), "resources.jobs.overlap.max_concurrent_runs")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(base, "databricks.yml"), runs.Location().File)
+}
+
+func TestLoadFromDirectoriesWithoutConfig(t *testing.T) {
+	_, err := LoadFromDirectories(t.TempDir())
+	assert.ErrorContains(t, err, "unable to find bundle configuration")
+}
+
+func TestLoadFromDirectoriesWithoutDirectories(t *testing.T) {
+	_, err := LoadFromDirectories()
+	assert.ErrorContains(t, err, "no directories specified")
+}
+
+func TestLoadWithExtends(t *testing.T) {
+	dir := t.TempDir()
+	baseDir := filepath.Join(dir, "base")
+	projectDir := filepath.Join(dir, "project")
+	require.NoError(t, os.Mkdir(baseDir, 0755))
+	require.NoError(t, os.Mkdir(projectDir, 0755))
+
+	writeBundleConfig(t, baseDir, `
+bundle:
+  name: base
+
+workspace:
+  host: https://base.cloud.databricks.com
+  root_path: /base
+
+resources:
+  jobs:
+    shared:
+      name: shared job
+      max_concurrent_runs: 2
+`)
+
+	writeBundleConfig(t, projectDir, `
+extends: ../base/databricks.yml
+
+bundle:
+  name: project
+
+workspace:
+  root_path: /project
+
+resources:
+  jobs:
+    shared:
+      name: project job
+`)
+
+	root, err := Load(filepath.Join(projectDir, "databricks.yml"))
+	require.NoError(t, err)
+
+	// The child configuration takes precedence.
+	assert.Equal(t, "project", root.Bundle.Name)
+	assert.Equal(t, "/project", root.Workspace.RootPath)
+	assert.Equal(t, "project job", root.Resources.Jobs["shared"].Name)
+
+	// Values that are not overridden are inherited from the base.
+	assert.Equal(t, "https://base.cloud.databricks.com", root.Workspace.Host)
+	assert.Equal(t, 2, root.Resources.Jobs["shared"].MaxConcurrentRuns)
+
+	// The bundle root is the directory of the child configuration.
+	assert.Equal(t, projectDir, root.Path)
+	assert.Equal(t, filepath.Join(baseDir, "databricks.yml"), root.Resources.Jobs["shared"].ConfigFilePath)
+
+	// Overridden fields keep the location of the child configuration.
+	name, err := dyn.Get(root.Value(), "resources.jobs.shared.name")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(projectDir, "databricks.yml"), name.Location().File)
+}
+
+func TestLoadWithExtendsChain(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yml"), []byte("workspace:\n  host: https://a.cloud.databricks.com\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte("extends: a.yml\nbundle:\n  name: b\n"), 0644))
+	writeBundleConfig(t, dir, "extends: b.yml\nbundle:\n  name: c\n")
+
+	root, err := Load(filepath.Join(dir, "databricks.yml"))
+	require.NoError(t, err)
+	assert.Equal(t, "c", root.Bundle.Name)
+	assert.Equal(t, "https://a.cloud.databricks.com", root.Workspace.Host)
+}
+
+func TestLoadWithExtendsCycle(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yml"), []byte("extends: b.yml\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte("extends: a.yml\n"), 0644))
+	writeBundleConfig(t, dir, "extends: a.yml\n")
+
+	_, err := Load(filepath.Join(dir, "databricks.yml"))
+	assert.ErrorContains(t, err, "cycle detected in extends: ")
+	assert.ErrorContains(t, err, filepath.Join(dir, "a.yml")+" -> "+filepath.Join(dir, "b.yml")+" -> "+filepath.Join(dir, "a.yml"))
+}
+
+func TestLoadWithExtendsSelf(t *testing.T) {
+	dir := t.TempDir()
+	writeBundleConfig(t, dir, "extends: ./databricks.yml\n")
+
+	_, err := Load(filepath.Join(dir, "databricks.yml"))
+	assert.ErrorContains(t, err, "cycle detected in extends: ")
+}
+
+func TestLoadWithExtendsMissingBase(t *testing.T) {
+	dir := t.TempDir()
+	writeBundleConfig(t, dir, "extends: ./doesnt_exist.yml\n")
+
+	_, err := Load(filepath.Join(dir, "databricks.yml"))
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestMergeTargetOverridesSequences(t *testing.T) {
+	dir := t.TempDir()
+	writeBundleConfig(t, dir, `
+sync:
+  include:
+    - a
+
+resources:
+  jobs:
+    foo:
+      email_notifications:
+        on_failure:
+          - a@example.com
+      tasks:
+        - task_key: a
+
+targets:
+  development:
+    sync:
+      include:
+        - b
+    resources:
+      jobs:
+        foo:
+          email_notifications:
+            on_failure:
+              - b@example.com
+          tasks:
+            - task_key: b
+`)
+
+	root, err := Load(filepath.Join(dir, "databricks.yml"))
+	require.NoError(t, err)
+
+	err = root.MergeTargetOverrides("development")
+	require.NoError(t, err)
+
+	// Sequences that are configured to be appended to.
+	assert.Equal(t, []string{"a", "b"}, root.Sync.Include)
+	require.Len(t, root.Resources.Jobs["foo"].Tasks, 2)
+	assert.Equal(t, "a", root.Resources.Jobs["foo"].Tasks[0].TaskKey)
+	assert.Equal(t, "b", root.Resources.Jobs["foo"].Tasks[1].TaskKey)
+
+	// All other sequences are replaced.
+	assert.Equal(t, []string{"b@example.com"}, root.Resources.Jobs["foo"].EmailNotifications.OnFailure)
+
+	// The strategy for every merged sequence is recorded.
+	var merges []string
+	for _, m := range root.SequenceMerges() {
+		strategy := "append"
+		if m.Strategy == merge.SequenceReplace {
+			strategy = "replace"
+		}
+		merges = append(merges, m.Path.String()+": "+strategy)
+		assert.Equal(t, filepath.Join(dir, "databricks.yml"), m.Location.File)
+	}
+	assert.ElementsMatch(t, []string{
+		"sync.include: append",
+		"resources.jobs.foo.tasks: append",
+		"resources.jobs.foo.email_notifications.on_failure: replace",
+	}, merges)
+}
+
+func TestMergeIncludedSequencesInTargets(t *testing.T) {
+	root := &Root{}
+	err := root.Mutate(func(_ dyn.Value) (dyn.Value, error) {
+		return dyn.V(map[string]dyn.Value{
+			"targets": dyn.V(map[string]dyn.Value{
+				"dev": dyn.V(map[string]dyn.Value{
+					"sync": dyn.V(map[string]dyn.Value{
+						"include": dyn.V([]dyn.Value{dyn.V("a")}),
+						"exclude": dyn.V([]dyn.Value{dyn.V("x")}),
+					}),
+				}),
+			}),
+		}), nil
+	})
+	require.NoError(t, err)
+
+	other := &Root{}
+	err = other.Mutate(func(_ dyn.Value) (dyn.Value, error) {
+		return dyn.V(map[string]dyn.Value{
+			"targets": dyn.V(map[string]dyn.Value{
+				"dev": dyn.V(map[string]dyn.Value{
+					"sync": dyn.V(map[string]dyn.Value{
+						"include": dyn.V([]dyn.Value{dyn.V("b")}),
+					}),
+				}),
+			}),
+		}), nil
+	})
+	require.NoError(t, err)
+
+	// Sequences in targets are merged like their top level counterparts.
+	err = root.Merge(other)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, root.Targets["dev"].Sync.Include)
+	assert.Equal(t, []string{"x"}, root.Targets["dev"].Sync.Exclude)
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+// SecretReferenceRegex matches references to secrets in the environment,
+// for example `${SECRET:DATABRICKS_TOKEN}`. The first submatch is the name
+// of the environment variable that holds the secret.
+var SecretReferenceRegex = regexp.MustCompile(`\$\{SECRET:([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+const redactedSecret = "[REDACTED]"
+
+// Secret is a string that holds a sensitive value, such as a token.
+// Its value is redacted when it is formatted or marshaled to JSON.
+type Secret string
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redactedSecret
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
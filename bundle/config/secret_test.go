@@ -0,0 +1,32 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretIsRedacted(t *testing.T) {
+	s := Secret("dapi1234")
+	assert.Equal(t, "[REDACTED]", s.String())
+	assert.Equal(t, "[REDACTED]", fmt.Sprint(s))
+
+	buf, err := json.Marshal(Workspace{Token: s})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"token":"[REDACTED]"}`, string(buf))
+
+	// An empty secret is not redacted.
+	assert.Equal(t, "", Secret("").String())
+}
+
+func TestSecretReferenceRegex(t *testing.T) {
+	m := SecretReferenceRegex.FindStringSubmatch("Bearer ${SECRET:MY_TOKEN}")
+	require.Len(t, m, 2)
+	assert.Equal(t, "MY_TOKEN", m[1])
+
+	assert.False(t, SecretReferenceRegex.MatchString("${var.token}"))
+	assert.False(t, SecretReferenceRegex.MatchString("${SECRET:}"))
+}
@@ -10,4 +10,8 @@ type Sync struct {
 	// 1) the default that observes the user's gitignore, or
 	// 2) the `Include` field above.
 	Exclude []string `json:"exclude,omitempty"`
+
+	// MaxConcurrency is the maximum number of files that are uploaded or deleted concurrently.
+	// If not set, a default of 10 is used.
+	MaxConcurrency int `json:"max_concurrency,omitempty"`
 }
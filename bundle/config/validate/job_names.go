@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+type jobNames struct{}
+
+// JobNames reports jobs with different resource keys that share the same name.
+// Such jobs are deployed fine, but are hard to tell apart in the workspace.
+func JobNames() Validator {
+	return &jobNames{}
+}
+
+func (v *jobNames) Name() string {
+	return "validate:job_names"
+}
+
+func (v *jobNames) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keysByName := make(map[string][]string)
+	for key, job := range b.Config.Resources.Jobs {
+		if job.JobSettings == nil || job.Name == "" {
+			continue
+		}
+		keysByName[job.Name] = append(keysByName[job.Name], key)
+	}
+
+	for name, keys := range keysByName {
+		if len(keys) < 2 {
+			continue
+		}
+
+		sort.Strings(keys)
+		for _, key := range keys {
+			diags = diags.Append(diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("job name %q is used by multiple jobs: %s", name, strings.Join(keys, ", ")),
+				Path:     dyn.NewPath(dyn.Key("resources"), dyn.Key("jobs"), dyn.Key(key), dyn.Key("name")),
+			})
+		}
+	}
+
+	return diags
+}
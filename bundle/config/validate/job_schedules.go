@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+type jobSchedules struct{}
+
+// JobSchedules reports job schedules with a malformed Quartz cron expression,
+// a missing timezone, or an unknown pause status.
+func JobSchedules() Validator {
+	return &jobSchedules{}
+}
+
+func (v *jobSchedules) Name() string {
+	return "validate:job_schedules"
+}
+
+func (v *jobSchedules) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for key, job := range b.Config.Resources.Jobs {
+		if job.JobSettings == nil || job.Schedule == nil {
+			continue
+		}
+
+		p := dyn.NewPath(dyn.Key("resources"), dyn.Key("jobs"), dyn.Key(key), dyn.Key("schedule"))
+		diags = diags.Extend(validateSchedule(p, job.Schedule))
+	}
+
+	return diags
+}
+
+func validateSchedule(p dyn.Path, schedule *jobs.CronSchedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Quartz cron expressions have 6 or 7 fields (seconds through an optional year).
+	fields := strings.Fields(schedule.QuartzCronExpression)
+	if !hasReferences(schedule.QuartzCronExpression) && (len(fields) < 6 || len(fields) > 7) {
+		diags = diags.Append(diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("invalid quartz cron expression %q; expected 6 or 7 fields", schedule.QuartzCronExpression),
+			Path:     p.Append(dyn.Key("quartz_cron_expression")),
+		})
+	}
+
+	if schedule.TimezoneId == "" {
+		diags = diags.Append(diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "schedule must specify a timezone_id",
+			Path:     p,
+		})
+	}
+
+	switch {
+	case hasReferences(string(schedule.PauseStatus)):
+	case schedule.PauseStatus == "", schedule.PauseStatus == jobs.PauseStatusPaused, schedule.PauseStatus == jobs.PauseStatusUnpaused:
+	default:
+		diags = diags.Append(diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("invalid pause status %q; expected one of %s, %s", schedule.PauseStatus, jobs.PauseStatusPaused, jobs.PauseStatusUnpaused),
+			Path:     p.Append(dyn.Key("pause_status")),
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/log"
+)
+
+type validate struct {
+	validators []Validator
+}
+
+// Mutator returns a mutator that runs the specified validators (or the default
+// set if none are specified) and returns an error if any of them reports an error.
+// Warnings are logged and don't fail the mutator.
+//
+// It is part of the initialize phase such that every command that deploys or
+// otherwise acts on the bundle rejects an invalid configuration up front.
+func Mutator(validators ...Validator) bundle.Mutator {
+	return &validate{validators}
+}
+
+func (m *validate) Name() string {
+	return "validate.Validate"
+}
+
+func (m *validate) Apply(ctx context.Context, b *bundle.Bundle) error {
+	diags := Validate(ctx, b, m.validators...)
+
+	var errs []string
+	for _, d := range diags {
+		switch d.Severity {
+		case diag.Error:
+			errs = append(errs, formatDiagnostic(d))
+		case diag.Warning:
+			log.Warnf(ctx, "%s", formatDiagnostic(d))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("found %d error(s) in bundle configuration:\n  %s", len(errs), strings.Join(errs, "\n  "))
+}
+
+func formatDiagnostic(d diag.Diagnostic) string {
+	s := d.Summary
+	if len(d.Path) > 0 {
+		s = fmt.Sprintf("%s: %s", d.Path, s)
+	}
+	if d.Location.File != "" {
+		s = fmt.Sprintf("%s (%s)", s, d.Location)
+	}
+	return s
+}
@@ -0,0 +1,112 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+type notebookPaths struct{}
+
+// NotebookPaths reports local notebook paths in jobs and pipelines
+// that do not point to an existing file.
+func NotebookPaths() Validator {
+	return &notebookPaths{}
+}
+
+func (v *notebookPaths) Name() string {
+	return "validate:notebook_paths"
+}
+
+func (v *notebookPaths) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for key, job := range b.Config.Resources.Jobs {
+		// Paths are relative to the remote repository if using git source.
+		if job.JobSettings == nil || job.GitSource != nil {
+			continue
+		}
+
+		for i, task := range job.Tasks {
+			if task.NotebookTask == nil {
+				continue
+			}
+
+			p := dyn.NewPath(
+				dyn.Key("resources"),
+				dyn.Key("jobs"),
+				dyn.Key(key),
+				dyn.Key("tasks"),
+				dyn.Index(i),
+				dyn.Key("notebook_task"),
+				dyn.Key("notebook_path"),
+			)
+			diags = diags.Extend(checkLocalNotebook(job.ConfigFilePath, task.NotebookTask.NotebookPath, p))
+		}
+	}
+
+	for key, pipeline := range b.Config.Resources.Pipelines {
+		if pipeline.PipelineSpec == nil {
+			continue
+		}
+
+		for i, library := range pipeline.Libraries {
+			if library.Notebook == nil {
+				continue
+			}
+
+			p := dyn.NewPath(
+				dyn.Key("resources"),
+				dyn.Key("pipelines"),
+				dyn.Key(key),
+				dyn.Key("libraries"),
+				dyn.Index(i),
+				dyn.Key("notebook"),
+				dyn.Key("path"),
+			)
+			diags = diags.Extend(checkLocalNotebook(pipeline.ConfigFilePath, library.Notebook.Path, p))
+		}
+	}
+
+	return diags
+}
+
+func checkLocalNotebook(configFilePath string, notebookPath string, p dyn.Path) diag.Diagnostics {
+	// We assume absolute paths point to a location in the workspace.
+	// Paths that contain references are only known after initialization.
+	if notebookPath == "" || path.IsAbs(filepath.ToSlash(notebookPath)) || hasReferences(notebookPath) {
+		return nil
+	}
+
+	// Paths with a scheme are remote paths.
+	if u, err := url.Parse(notebookPath); err == nil && u.Scheme != "" {
+		return nil
+	}
+
+	// Glob patterns in pipeline libraries are expanded by a mutator.
+	if strings.ContainsAny(notebookPath, "*?[") {
+		return nil
+	}
+
+	localPath := filepath.Join(filepath.Dir(configFilePath), filepath.FromSlash(notebookPath))
+	_, err := os.Stat(localPath)
+	if err == nil {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("notebook %s not found", notebookPath),
+			Path:     p,
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+// Permission levels that can be specified at the top level of the configuration.
+// These are translated to resource specific levels when permissions are applied.
+var bundlePermissionLevels = []string{"CAN_MANAGE", "CAN_VIEW", "CAN_RUN"}
+
+// Permission levels that can be specified on individual resources, keyed by resource type.
+var resourcePermissionLevels = map[string][]string{
+	"jobs":                    {"CAN_MANAGE", "CAN_MANAGE_RUN", "CAN_VIEW", "IS_OWNER"},
+	"pipelines":               {"CAN_MANAGE", "CAN_RUN", "CAN_VIEW", "IS_OWNER"},
+	"experiments":             {"CAN_MANAGE", "CAN_EDIT", "CAN_READ"},
+	"models":                  {"CAN_MANAGE", "CAN_EDIT", "CAN_READ", "CAN_MANAGE_STAGING_VERSIONS", "CAN_MANAGE_PRODUCTION_VERSIONS"},
+	"model_serving_endpoints": {"CAN_MANAGE", "CAN_QUERY", "CAN_VIEW"},
+}
+
+type permissions struct{}
+
+// Permissions reports permission entries that use a level not supported
+// by the resource they are defined on, that don't specify exactly one principal,
+// or that repeat a principal of an earlier entry.
+func Permissions() Validator {
+	return &permissions{}
+}
+
+func (v *permissions) Name() string {
+	return "validate:permissions"
+}
+
+func (v *permissions) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags = diags.Extend(checkPermissions(dyn.NewPath(dyn.Key("permissions")), b.Config.Permissions, bundlePermissionLevels))
+
+	r := b.Config.Resources
+	for key, job := range r.Jobs {
+		diags = diags.Extend(checkResourcePermissions("jobs", key, job.Permissions))
+	}
+	for key, pipeline := range r.Pipelines {
+		diags = diags.Extend(checkResourcePermissions("pipelines", key, pipeline.Permissions))
+	}
+	for key, experiment := range r.Experiments {
+		diags = diags.Extend(checkResourcePermissions("experiments", key, experiment.Permissions))
+	}
+	for key, model := range r.Models {
+		diags = diags.Extend(checkResourcePermissions("models", key, model.Permissions))
+	}
+	for key, endpoint := range r.ModelServingEndpoints {
+		diags = diags.Extend(checkResourcePermissions("model_serving_endpoints", key, endpoint.Permissions))
+	}
+
+	return diags
+}
+
+func checkResourcePermissions(resourceType, key string, ps []resources.Permission) diag.Diagnostics {
+	p := dyn.NewPath(dyn.Key("resources"), dyn.Key(resourceType), dyn.Key(key), dyn.Key("permissions"))
+	return checkPermissions(p, ps, resourcePermissionLevels[resourceType])
+}
+
+func checkPermissions(base dyn.Path, ps []resources.Permission, levels []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := make(map[string]int)
+	for i, perm := range ps {
+		p := base.Append(dyn.Index(i))
+
+		if !slices.Contains(levels, perm.Level) && !hasReferences(perm.Level) {
+			diags = diags.Append(diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("invalid permission level %q; expected one of %s", perm.Level, strings.Join(levels, ", ")),
+				Path:     p.Append(dyn.Key("level")),
+			})
+		}
+
+		n := 0
+		for _, principal := range []string{perm.UserName, perm.ServicePrincipalName, perm.GroupName} {
+			if principal != "" {
+				n++
+			}
+		}
+		if n != 1 {
+			diags = diags.Append(diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "permission must specify exactly one of user_name, service_principal_name, or group_name",
+				Path:     p,
+			})
+			continue
+		}
+
+		// A principal can only be assigned a single permission level per resource.
+		principal := perm.UserName + perm.ServicePrincipalName + perm.GroupName
+		if j, ok := seen[principal]; ok {
+			diags = diags.Append(diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("principal %q is already assigned a permission at %s", principal, base.Append(dyn.Index(j))),
+				Path:     p,
+			})
+			continue
+		}
+		seen[principal] = i
+	}
+
+	return diags
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"sort"
+
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+// Group holds the diagnostics that relate to the same section of the configuration,
+// for example a single job or the top level `workspace` section.
+type Group struct {
+	// Path to the configuration section. It is empty for diagnostics without a path.
+	Path dyn.Path
+
+	Diagnostics diag.Diagnostics
+}
+
+// GroupByPath groups diagnostics by the configuration section they relate to.
+// Groups are ordered by path and retain the order of diagnostics within them.
+func GroupByPath(diags diag.Diagnostics) []Group {
+	var groups []Group
+	index := make(map[string]int)
+
+	for _, d := range diags {
+		p := sectionOf(d.Path)
+		k := p.String()
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, Group{Path: p})
+		}
+		groups[i].Diagnostics = groups[i].Diagnostics.Append(d)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Path.String() < groups[j].Path.String()
+	})
+
+	return groups
+}
+
+// sectionOf returns the path to the section that contains the specified path.
+// Resources are grouped per resource (e.g. `resources.jobs.foo`) and
+// everything else is grouped per top level key (e.g. `workspace`).
+func sectionOf(p dyn.Path) dyn.Path {
+	n := 1
+	if len(p) > 0 && p[0].Key() == "resources" {
+		n = 3
+	}
+	if len(p) < n {
+		n = len(p)
+	}
+	return dyn.NewPath(p[:n]...)
+}
@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+// Resource keys are used to derive Terraform resource names and
+// to refer to resources from the command line (e.g. `bundle run <key>`).
+var resourceKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+type resourceKeys struct{}
+
+// ResourceKeys reports resource keys that contain characters other than
+// letters, digits, underscores, and dashes.
+func ResourceKeys() Validator {
+	return &resourceKeys{}
+}
+
+func (v *resourceKeys) Name() string {
+	return "validate:resource_keys"
+}
+
+func (v *resourceKeys) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pattern := dyn.NewPattern(dyn.Key("resources"), dyn.AnyKey(), dyn.AnyKey())
+	_, err := dyn.MapByPattern(b.Config.Value(), pattern, func(p dyn.Path, rv dyn.Value) (dyn.Value, error) {
+		key := p[len(p)-1].Key()
+		if !resourceKeyRegex.MatchString(key) {
+			diags = diags.Append(diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("invalid resource key %q; only letters, digits, underscores, and dashes are allowed", key),
+				Location: rv.Location(),
+				Path:     slices.Clone(p),
+			})
+		}
+		return rv, nil
+	})
+	if err != nil {
+		return diags.Extend(diag.Errorf("unable to inspect resources: %s", err))
+	}
+
+	return diags
+}
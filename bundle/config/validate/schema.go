@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/schema"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/jsonschema"
+)
+
+type configSchema struct{}
+
+// Schema validates resource definitions against the JSON schema generated from
+// the configuration types and reports required fields that are missing.
+// Unknown fields and type mismatches are reported while loading the configuration.
+//
+// It runs as part of the initialize phase (see [Mutator]), such that every command
+// rejects a configuration that doesn't match the schema before acting on it.
+// Target overrides of job tasks and clusters must be merged and workspace defaults
+// must be applied before running this validator, because these fill in required fields.
+func Schema() Validator {
+	return &configSchema{}
+}
+
+func (v *configSchema) Name() string {
+	return "validate:schema"
+}
+
+var rootSchema = sync.OnceValues(func() (*jsonschema.Schema, error) {
+	return schema.New(reflect.TypeOf(config.Root{}), nil)
+})
+
+func (v *configSchema) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	s, err := rootSchema()
+	if err != nil {
+		return diag.Errorf("unable to generate configuration schema: %v", err)
+	}
+
+	// Only the resources of the selected target are validated. Target overrides
+	// are partial by design and would report fields defined at the top level.
+	p := dyn.NewPath(dyn.Key("resources"))
+	resources, err := dyn.GetByPath(b.Config.Value(), p)
+	if err != nil {
+		return nil
+	}
+
+	return validateAgainstSchema(p, resources, s.Properties["resources"])
+}
+
+func validateAgainstSchema(p dyn.Path, v dyn.Value, s *jsonschema.Schema) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if s == nil {
+		return diags
+	}
+
+	switch v.Kind() {
+	case dyn.KindMap:
+		m := v.MustMap()
+		for _, name := range s.Required {
+			if _, ok := m[name]; ok {
+				continue
+			}
+			diags = diags.Append(diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("missing required field %q", name),
+				Path:     slices.Clone(p),
+			})
+		}
+
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			child, ok := s.Properties[k]
+			if !ok {
+				child, _ = s.AdditionalProperties.(*jsonschema.Schema)
+			}
+			diags = diags.Extend(validateAgainstSchema(p.Append(dyn.Key(k)), m[k], child))
+		}
+	case dyn.KindSequence:
+		for i, item := range v.MustSequence() {
+			diags = diags.Extend(validateAgainstSchema(p.Append(dyn.Index(i)), item, s.Items))
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,43 @@
+package validate
+
+import (
+	"context"
+	"slices"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn/merge"
+)
+
+type sequenceMerges struct{}
+
+// SequenceMerges reports the strategy that was used to merge sequences that are
+// defined in more than one place, for example in an included file or a target override.
+// Sequences are replaced unless they are configured to be appended to, such as job tasks.
+func SequenceMerges() Validator {
+	return &sequenceMerges{}
+}
+
+func (v *sequenceMerges) Name() string {
+	return "validate:sequence_merges"
+}
+
+func (v *sequenceMerges) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, m := range b.Config.SequenceMerges() {
+		summary := "sequence is replaced by the sequence that takes precedence (merge strategy: replace)"
+		if m.Strategy == merge.SequenceAppend {
+			summary = "sequence is appended to with the sequence that takes precedence (merge strategy: append)"
+		}
+
+		diags = diags.Append(diag.Diagnostic{
+			Severity: diag.Info,
+			Summary:  summary,
+			Location: m.Location,
+			Path:     slices.Clone(m.Path),
+		})
+	}
+
+	return diags
+}
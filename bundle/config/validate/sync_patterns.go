@@ -0,0 +1,48 @@
+package validate
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/fileset"
+)
+
+type syncPatterns struct{}
+
+// SyncPatterns reports malformed glob patterns in the sync include and exclude lists.
+func SyncPatterns() Validator {
+	return &syncPatterns{}
+}
+
+func (v *syncPatterns) Name() string {
+	return "validate:sync_patterns"
+}
+
+func (v *syncPatterns) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags = diags.Extend(checkPatterns("include", b.Config.Sync.Include))
+	diags = diags.Extend(checkPatterns("exclude", b.Config.Sync.Exclude))
+	return diags
+}
+
+func checkPatterns(field string, patterns []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, pattern := range patterns {
+		if hasReferences(pattern) {
+			continue
+		}
+		err := fileset.ValidatePattern(pattern)
+		if err == nil {
+			continue
+		}
+
+		diags = diags.Append(diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Path:     dyn.NewPath(dyn.Key("sync"), dyn.Key(field), dyn.Index(i)),
+		})
+	}
+	return diags
+}
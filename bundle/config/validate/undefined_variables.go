@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+// Matches the shorthand variable reference ${var.foo}.
+var variableReferenceRegex = regexp.MustCompile(`\$\{var\.([a-zA-Z]+([-_]?[a-zA-Z0-9]+)*)\}`)
+
+type undefinedVariables struct{}
+
+// UndefinedVariables reports references to variables that are not defined
+// in the top level `variables` section of the configuration.
+func UndefinedVariables() Validator {
+	return &undefinedVariables{}
+}
+
+func (v *undefinedVariables) Name() string {
+	return "validate:undefined_variables"
+}
+
+func (v *undefinedVariables) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, err := dyn.Walk(b.Config.Value(), func(p dyn.Path, v dyn.Value) (dyn.Value, error) {
+		// Variables in target overrides are validated when the target is selected.
+		if p.HasPrefix(dyn.NewPath(dyn.Key("targets"))) || p.HasPrefix(dyn.NewPath(dyn.Key("environments"))) {
+			return v, dyn.ErrSkip
+		}
+
+		s, ok := v.AsString()
+		if !ok {
+			return v, nil
+		}
+
+		for _, m := range variableReferenceRegex.FindAllStringSubmatch(s, -1) {
+			if _, ok := b.Config.Variables[m[1]]; ok {
+				continue
+			}
+			diags = diags.Append(diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("reference to undefined variable %s", m[1]),
+				Location: v.Location(),
+				Path:     slices.Clone(p),
+			})
+		}
+
+		return v, nil
+	})
+	if err != nil {
+		return diags.Extend(diag.Errorf("unable to inspect variable references: %s", err))
+	}
+
+	return diags
+}
@@ -0,0 +1,87 @@
+// Package validate implements checks on bundle configuration that report
+// all problems they find as diagnostics instead of failing on the first one.
+package validate
+
+import (
+	"context"
+	"sort"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+	"github.com/databricks/cli/libs/log"
+)
+
+// Validator inspects the configuration of a bundle and returns
+// a diagnostic for every problem it finds. It must not mutate the bundle.
+type Validator interface {
+	// Name returns the validators name.
+	Name() string
+
+	// Validate returns diagnostics for the specified bundle.
+	Validate(context.Context, *bundle.Bundle) diag.Diagnostics
+}
+
+// Validators returns the default set of validators.
+func Validators() []Validator {
+	return []Validator{
+		ResourceKeys(),
+		JobSchedules(),
+		UndefinedVariables(),
+		NotebookPaths(),
+		SyncPatterns(),
+		Permissions(),
+		WorkspaceHost(),
+		WorkspaceProfile(),
+		WorkspacePaths(),
+		JobNames(),
+		Schema(),
+		SequenceMerges(),
+	}
+}
+
+// Validate runs the specified validators (or the default set if none are specified)
+// against the bundle and returns their combined diagnostics, sorted by configuration path.
+// Diagnostics recorded while loading the configuration are included as well.
+func Validate(ctx context.Context, b *bundle.Bundle, validators ...Validator) diag.Diagnostics {
+	if len(validators) == 0 {
+		validators = Validators()
+	}
+
+	diags := b.Config.Diagnostics()
+	for _, v := range validators {
+		log.Debugf(ctx, "Validate: %s", v.Name())
+		diags = diags.Extend(v.Validate(ctx, b))
+	}
+
+	// Populate locations from the configuration tree where possible.
+	for i := range diags {
+		diags[i].Location = locationOf(b, diags[i])
+	}
+
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diags[i].Path.String() < diags[j].Path.String()
+	})
+
+	return diags
+}
+
+// hasReferences returns true if the value contains variable references.
+// Validators run before references are resolved, so such values are not checked.
+func hasReferences(s string) bool {
+	return len(dynvar.References(s)) > 0
+}
+
+func locationOf(b *bundle.Bundle, d diag.Diagnostic) dyn.Location {
+	if d.Location.File != "" || len(d.Path) == 0 {
+		return d.Location
+	}
+
+	v, err := dyn.GetByPath(b.Config.Value(), d.Path)
+	if err != nil {
+		return d.Location
+	}
+
+	return v.Location()
+}
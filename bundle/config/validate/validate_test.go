@@ -0,0 +1,562 @@
+package validate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadBundle(t *testing.T, contents string) *bundle.Bundle {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "databricks.yml")
+	err := os.WriteFile(path, []byte(contents), 0644)
+	require.NoError(t, err)
+
+	root, err := config.Load(path)
+	require.NoError(t, err)
+	return &bundle.Bundle{Config: *root}
+}
+
+func summaries(diags diag.Diagnostics) []string {
+	var out []string
+	for _, d := range diags {
+		out = append(out, d.Path.String()+": "+d.Summary)
+	}
+	return out
+}
+
+func TestValidateAggregatesAllErrors(t *testing.T) {
+	b := loadBundle(t, `
+bundle:
+  name: test
+
+variables:
+  defined:
+    default: foo
+
+resources:
+  jobs:
+    "bad key":
+      name: job
+    job:
+      name: ${var.defined} ${var.undefined}
+      schedule:
+        quartz_cron_expression: "* * *"
+        timezone_id: UTC
+      tasks:
+        - task_key: notebook
+          notebook_task:
+            notebook_path: ./missing.py
+`)
+
+	diags := Validate(context.Background(), b)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, []string{
+		`resources.jobs.bad key: invalid resource key "bad key"; only letters, digits, underscores, and dashes are allowed`,
+		`resources.jobs.job.name: reference to undefined variable undefined`,
+		`resources.jobs.job.schedule.quartz_cron_expression: invalid quartz cron expression "* * *"; expected 6 or 7 fields`,
+		`resources.jobs.job.tasks[0].notebook_task.notebook_path: notebook ./missing.py not found`,
+	}, summaries(diags))
+
+	// All diagnostics have a location in the configuration file.
+	for _, d := range diags {
+		assert.Equal(t, filepath.Join(b.Config.Path, "databricks.yml"), d.Location.File)
+	}
+}
+
+func TestValidateWithoutErrors(t *testing.T) {
+	b := loadBundle(t, `
+bundle:
+  name: test
+
+resources:
+  jobs:
+    job:
+      name: job
+      schedule:
+        quartz_cron_expression: "0 0 12 * * ?"
+        timezone_id: UTC
+        pause_status: PAUSED
+      tasks:
+        - task_key: notebook
+          notebook_task:
+            notebook_path: /Workspace/absolute/path
+`)
+
+	diags := Validate(context.Background(), b)
+	assert.Empty(t, diags)
+}
+
+func TestValidateSkipsValuesWithReferences(t *testing.T) {
+	b := loadBundle(t, `
+bundle:
+  name: test
+
+variables:
+  cron:
+    default: "0 0 12 * * ?"
+  level:
+    default: CAN_VIEW
+
+workspace:
+  host: ${var.host}
+
+sync:
+  include:
+    - ${var.include}
+
+permissions:
+  - level: ${var.level}
+    group_name: users
+
+resources:
+  jobs:
+    job:
+      name: job
+      schedule:
+        quartz_cron_expression: ${var.cron}
+        timezone_id: UTC
+      tasks:
+        - task_key: notebook
+          notebook_task:
+            notebook_path: ${workspace.file_path}/notebook
+`)
+
+	diags := Validate(context.Background(), b, JobSchedules(), NotebookPaths(), SyncPatterns(), Permissions(), WorkspaceHost())
+	assert.Empty(t, summaries(diags))
+}
+
+func TestValidateJobSchedules(t *testing.T) {
+	b := loadBundle(t, `
+resources:
+  jobs:
+    job:
+      schedule:
+        quartz_cron_expression: "0 0 12 * * ?"
+        pause_status: SOMETIMES
+`)
+
+	diags := Validate(context.Background(), b, JobSchedules())
+	assert.Equal(t, []string{
+		`resources.jobs.job.schedule: schedule must specify a timezone_id`,
+		`resources.jobs.job.schedule.pause_status: invalid pause status "SOMETIMES"; expected one of PAUSED, UNPAUSED`,
+	}, summaries(diags))
+}
+
+func TestValidateNotebookPathsInPipelines(t *testing.T) {
+	b := loadBundle(t, `
+resources:
+  pipelines:
+    pipeline:
+      libraries:
+        - notebook:
+            path: ./missing.py
+        - notebook:
+            path: ./glob/*.py
+`)
+
+	diags := Validate(context.Background(), b, NotebookPaths())
+	assert.Equal(t, []string{
+		`resources.pipelines.pipeline.libraries[0].notebook.path: notebook ./missing.py not found`,
+	}, summaries(diags))
+}
+
+func TestValidateSyncPatterns(t *testing.T) {
+	b := loadBundle(t, `
+sync:
+  include:
+    - "src/**/*.py"
+    - "[a-z"
+  exclude:
+    - "!keep.txt"
+    - "foo/["
+`)
+
+	diags := Validate(context.Background(), b, SyncPatterns())
+	assert.Equal(t, []string{
+		`sync.exclude[1]: pattern "foo/[" is invalid: syntax error in pattern`,
+		`sync.include[1]: pattern "[a-z" is invalid: syntax error in pattern`,
+	}, summaries(diags))
+	for _, d := range diags {
+		assert.Equal(t, diag.Error, d.Severity)
+		assert.NotEmpty(t, d.Location.File)
+	}
+}
+
+func TestValidatePermissions(t *testing.T) {
+	b := loadBundle(t, `
+permissions:
+  - level: CAN_RUN
+    group_name: users
+  - level: CAN_MANAGE_RUN
+    user_name: jane@doe.com
+
+resources:
+  jobs:
+    job:
+      permissions:
+        - level: CAN_MANAGE_RUN
+          group_name: users
+        - level: CAN_READ
+          user_name: jane@doe.com
+  pipelines:
+    pipeline:
+      permissions:
+        - level: CAN_RUN
+        - level: CAN_VIEW
+          user_name: jane@doe.com
+          group_name: users
+  models:
+    model:
+      permissions:
+        - level: CAN_MANAGE_PRODUCTION_VERSIONS
+          service_principal_name: sp
+`)
+
+	diags := Validate(context.Background(), b, Permissions())
+	assert.Equal(t, []string{
+		`permissions[1].level: invalid permission level "CAN_MANAGE_RUN"; expected one of CAN_MANAGE, CAN_VIEW, CAN_RUN`,
+		`resources.jobs.job.permissions[1].level: invalid permission level "CAN_READ"; expected one of CAN_MANAGE, CAN_MANAGE_RUN, CAN_VIEW, IS_OWNER`,
+		`resources.pipelines.pipeline.permissions[0]: permission must specify exactly one of user_name, service_principal_name, or group_name`,
+		`resources.pipelines.pipeline.permissions[1]: permission must specify exactly one of user_name, service_principal_name, or group_name`,
+	}, summaries(diags))
+}
+
+func TestValidatePermissionsDuplicatePrincipal(t *testing.T) {
+	b := loadBundle(t, `
+resources:
+  jobs:
+    job:
+      permissions:
+        - level: CAN_VIEW
+          group_name: users
+        - level: CAN_MANAGE
+          user_name: jane@doe.com
+        - level: CAN_MANAGE_RUN
+          group_name: users
+`)
+
+	diags := Validate(context.Background(), b, Permissions())
+	assert.Equal(t, []string{
+		`resources.jobs.job.permissions[2]: principal "users" is already assigned a permission at resources.jobs.job.permissions[0]`,
+	}, summaries(diags))
+}
+
+func TestValidateWorkspaceHost(t *testing.T) {
+	b := loadBundle(t, `
+workspace:
+  host: http://abc.cloud.databricks.com
+`)
+
+	diags := Validate(context.Background(), b, WorkspaceHost())
+	assert.Equal(t, []string{
+		`workspace.host: invalid workspace host "http://abc.cloud.databricks.com": expected a URL starting with https://`,
+	}, summaries(diags))
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+
+	// The host is optional.
+	b = loadBundle(t, `
+bundle:
+  name: foo
+`)
+	assert.Empty(t, Validate(context.Background(), b, WorkspaceHost()))
+}
+
+func TestValidateWorkspacePaths(t *testing.T) {
+	b := loadBundle(t, `
+workspace:
+  root_path: /Users/someone/.bundle/foo
+  file_path: /Users/someone/.bundle/foo
+  state_path: /Users/someone/state
+`)
+	assert.Equal(t, []string{
+		`workspace.artifact_path: artifact_path "/Users/someone/.bundle/foo/artifacts" must not be located inside file_path "/Users/someone/.bundle/foo"`,
+	}, summaries(Validate(context.Background(), b, WorkspacePaths())))
+
+	b = loadBundle(t, `
+workspace:
+  file_path: /Users/someone/files
+  artifact_path: /Users/someone/artifacts
+  state_path: /Users/someone/files/state
+`)
+	assert.Equal(t, []string{
+		`workspace.state_path: state_path "/Users/someone/files/state" must not be located inside file_path "/Users/someone/files"`,
+	}, summaries(Validate(context.Background(), b, WorkspacePaths())))
+
+	// Default paths are distinct and paths with variable references are skipped.
+	b = loadBundle(t, `
+workspace:
+  root_path: /Users/someone/.bundle/foo
+  state_path: ${workspace.file_path}/state
+`)
+	assert.Empty(t, Validate(context.Background(), b, WorkspacePaths()))
+
+	// A sibling with a common prefix is not located inside the file path.
+	b = loadBundle(t, `
+workspace:
+  file_path: /Users/someone/files
+  artifact_path: /Users/someone/files-artifacts
+`)
+	assert.Empty(t, Validate(context.Background(), b, WorkspacePaths()))
+}
+
+func TestValidateWorkspaceProfile(t *testing.T) {
+	cfg := filepath.Join(t.TempDir(), ".databrickscfg")
+	err := os.WriteFile(cfg, []byte("[dev]\nhost = https://dev.cloud.databricks.com\n"), 0600)
+	require.NoError(t, err)
+	t.Setenv("DATABRICKS_CONFIG_FILE", cfg)
+
+	ctx := context.Background()
+
+	b := loadBundle(t, `
+workspace:
+  profile: ""
+`)
+	assert.Equal(t, []string{
+		`workspace.profile: profile must be a non-empty string`,
+	}, summaries(Validate(ctx, b, WorkspaceProfile())))
+
+	b = loadBundle(t, `
+workspace:
+  profile: dev
+  host: https://dev.cloud.databricks.com/
+`)
+	assert.Empty(t, Validate(ctx, b, WorkspaceProfile()))
+
+	b = loadBundle(t, `
+workspace:
+  profile: dev
+  host: https://prod.cloud.databricks.com
+`)
+	assert.Equal(t, []string{
+		`workspace.profile: config host mismatch: profile uses host https://dev.cloud.databricks.com, but CLI configured to use https://prod.cloud.databricks.com`,
+	}, summaries(Validate(ctx, b, WorkspaceProfile())))
+
+	// Profiles that don't exist are reported when authenticating.
+	b = loadBundle(t, `
+workspace:
+  profile: unknown
+  host: https://prod.cloud.databricks.com
+`)
+	assert.Empty(t, Validate(ctx, b, WorkspaceProfile()))
+}
+
+func TestValidateJobNames(t *testing.T) {
+	b := loadBundle(t, `
+resources:
+  jobs:
+    foo:
+      name: shared
+    bar:
+      name: shared
+    baz:
+      name: unique
+`)
+
+	diags := Validate(context.Background(), b, JobNames())
+	assert.Equal(t, []string{
+		`resources.jobs.bar.name: job name "shared" is used by multiple jobs: bar, foo`,
+		`resources.jobs.foo.name: job name "shared" is used by multiple jobs: bar, foo`,
+	}, summaries(diags))
+	for _, d := range diags {
+		assert.Equal(t, diag.Warning, d.Severity)
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	b := loadBundle(t, `
+resources:
+  jobs:
+    my_job:
+      tasks:
+        - task_key: a
+          notebook_task:
+            notebook_path: ./a.py
+        - task_key: b
+          spark_python_task:
+            python_file: ./b.py
+        - notebook_task:
+            base_parameters:
+              foo: bar
+  registered_models:
+    my_model:
+      name: model
+      catalog_name: main
+
+targets:
+  dev:
+    resources:
+      registered_models:
+        my_model:
+          name: dev-model
+`)
+
+	diags := Validate(context.Background(), b, Schema())
+	assert.Equal(t, []string{
+		`resources.jobs.my_job.tasks[2]: missing required field "task_key"`,
+		`resources.jobs.my_job.tasks[2].notebook_task: missing required field "notebook_path"`,
+		`resources.registered_models.my_model: missing required field "schema_name"`,
+	}, summaries(diags))
+}
+
+func TestValidateSchemaPathsOfSiblings(t *testing.T) {
+	b := loadBundle(t, `
+resources:
+  jobs:
+    my_job:
+      tasks:
+        - task_key: a
+          notebook_task:
+            base_parameters:
+              foo: bar
+        - notebook_task:
+            notebook_path: ./b.py
+`)
+
+	diags := Validate(context.Background(), b, Schema())
+	assert.Equal(t, []string{
+		`resources.jobs.my_job.tasks[0].notebook_task: missing required field "notebook_path"`,
+		`resources.jobs.my_job.tasks[1]: missing required field "task_key"`,
+	}, summaries(diags))
+}
+
+func TestValidateSchemaAfterMergingTargetOverrides(t *testing.T) {
+	b := loadBundle(t, `
+workspace:
+  defaults:
+    spark_version: 14.3.x-scala2.12
+
+resources:
+  jobs:
+    my_job:
+      job_clusters:
+        - job_cluster_key: default
+          new_cluster:
+            num_workers: 1
+      tasks:
+        - task_key: a
+          notebook_task:
+            notebook_path: ./a.py
+
+targets:
+  dev:
+    resources:
+      jobs:
+        my_job:
+          tasks:
+            - task_key: a
+              max_retries: 3
+`)
+
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SelectTarget("dev"),
+		mutator.MergeJobTasks(),
+		mutator.ApplyWorkspaceDefaults(),
+	))
+	require.NoError(t, err)
+	assert.Empty(t, Validate(context.Background(), b, Schema()))
+}
+
+func TestValidateSequenceMerges(t *testing.T) {
+	b := loadBundle(t, `
+resources:
+  jobs:
+    job:
+      email_notifications:
+        on_failure:
+          - a@example.com
+      tasks:
+        - task_key: a
+
+targets:
+  dev:
+    resources:
+      jobs:
+        job:
+          email_notifications:
+            on_failure:
+              - b@example.com
+          tasks:
+            - task_key: b
+`)
+	err := b.Config.MergeTargetOverrides("dev")
+	require.NoError(t, err)
+
+	diags := Validate(context.Background(), b, SequenceMerges())
+	assert.Equal(t, []string{
+		`resources.jobs.job.email_notifications.on_failure: sequence is replaced by the sequence that takes precedence (merge strategy: replace)`,
+		`resources.jobs.job.tasks: sequence is appended to with the sequence that takes precedence (merge strategy: append)`,
+	}, summaries(diags))
+
+	// Diagnostics point to the sequence in the target override.
+	assert.Equal(t, diag.Info, diags[0].Severity)
+	assert.Equal(t, 18, diags[0].Location.Line)
+	assert.Equal(t, diag.Info, diags[1].Severity)
+	assert.Equal(t, 20, diags[1].Location.Line)
+}
+
+func TestGroupByPath(t *testing.T) {
+	diags := diag.Diagnostics{
+		{Summary: "a", Path: dyn.MustPathFromString("workspace.host")},
+		{Summary: "b", Path: dyn.MustPathFromString("resources.jobs.foo.name")},
+		{Summary: "c", Path: dyn.MustPathFromString("resources.jobs.bar.name")},
+		{Summary: "d", Path: dyn.MustPathFromString("resources.jobs.foo.tasks[0].task_key")},
+		{Summary: "e"},
+	}
+
+	groups := GroupByPath(diags)
+	require.Len(t, groups, 4)
+	assert.Equal(t, "", groups[0].Path.String())
+	assert.Equal(t, []string{": e"}, summaries(groups[0].Diagnostics))
+	assert.Equal(t, "resources.jobs.bar", groups[1].Path.String())
+	assert.Equal(t, "resources.jobs.foo", groups[2].Path.String())
+	assert.Len(t, groups[2].Diagnostics, 2)
+	assert.Equal(t, "workspace", groups[3].Path.String())
+}
+
+func TestValidateMutator(t *testing.T) {
+	b := loadBundle(t, `
+workspace:
+  host: http://abc.cloud.databricks.com
+
+resources:
+  jobs:
+    job:
+      permissions:
+        - level: CAN_READ
+          user_name: jane@doe.com
+`)
+
+	err := bundle.Apply(context.Background(), b, Mutator())
+	assert.ErrorContains(t, err, "found 1 error(s) in bundle configuration:\n")
+	assert.ErrorContains(t, err, `resources.jobs.job.permissions[0].level: invalid permission level "CAN_READ"`)
+
+	// Warnings don't fail the mutator.
+	assert.NotContains(t, err.Error(), "workspace.host")
+}
+
+func TestValidateMutatorWithoutErrors(t *testing.T) {
+	b := loadBundle(t, `
+workspace:
+  host: http://abc.cloud.databricks.com
+
+resources:
+  jobs:
+    job:
+      permissions:
+        - level: CAN_VIEW
+          user_name: jane@doe.com
+`)
+
+	err := bundle.Apply(context.Background(), b, Mutator())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,43 @@
+package validate
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+type workspaceHost struct{}
+
+// WorkspaceHost warns about a workspace host that is not a well-formed https:// URL.
+// The SDK normalizes such hosts, but they are easy to confuse with a different workspace.
+// The host is optional because it can also be configured through a profile or the environment.
+func WorkspaceHost() Validator {
+	return &workspaceHost{}
+}
+
+func (v *workspaceHost) Name() string {
+	return "validate:workspace_host"
+}
+
+func (v *workspaceHost) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	host := b.Config.Workspace.Host
+	if host == "" || hasReferences(host) {
+		return nil
+	}
+
+	err := config.ValidateHost(host)
+	if err == nil {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  err.Error(),
+			Path:     dyn.NewPath(dyn.Key("workspace"), dyn.Key("host")),
+		},
+	}
+}
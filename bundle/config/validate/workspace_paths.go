@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+type workspacePaths struct{}
+
+// WorkspacePaths reports an artifact or state path that is located inside the file path.
+// The file path is managed by file synchronization, so anything else written there
+// can be overwritten or removed by a subsequent deployment.
+func WorkspacePaths() Validator {
+	return &workspacePaths{}
+}
+
+func (v *workspacePaths) Name() string {
+	return "validate:workspace_paths"
+}
+
+// workspacePath returns the configured path, or its default below the
+// workspace root if it isn't set (see mutator.DefineDefaultWorkspacePaths).
+// It returns an empty string if the path cannot be determined before
+// variable references are resolved.
+func workspacePath(root, p, name string) string {
+	if p == "" && root != "" {
+		p = path.Join(root, name)
+	}
+	if hasReferences(p) {
+		return ""
+	}
+	return p
+}
+
+func isWithin(parent, p string) bool {
+	parent = path.Clean(parent)
+	p = path.Clean(p)
+	return p == parent || strings.HasPrefix(p, strings.TrimSuffix(parent, "/")+"/")
+}
+
+func (v *workspacePaths) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ws := b.Config.Workspace
+	filePath := workspacePath(ws.RootPath, ws.FilePath, "files")
+	if filePath == "" {
+		return nil
+	}
+
+	// Artifacts on DBFS or S3 cannot be located inside the file path.
+	artifactPath := ""
+	if ws.ArtifactPath.IsWorkspace() {
+		artifactPath = workspacePath(ws.RootPath, ws.ArtifactPath.String(), "artifacts")
+	}
+
+	for _, other := range []struct {
+		key  string
+		path string
+	}{
+		{"artifact_path", artifactPath},
+		{"state_path", workspacePath(ws.RootPath, ws.StatePath, "state")},
+	} {
+		if other.path == "" || !isWithin(filePath, other.path) {
+			continue
+		}
+
+		diags = diags.Append(diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s %q must not be located inside file_path %q", other.key, other.path, filePath),
+			Path:     dyn.NewPath(dyn.Key("workspace"), dyn.Key(other.key)),
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,78 @@
+package validate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/databrickscfg"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/databricks-sdk-go/config"
+)
+
+type workspaceProfile struct{}
+
+// WorkspaceProfile reports an empty workspace profile, and a profile that
+// is associated with a different host than the one configured in the bundle.
+//
+// The profile itself is resolved when authenticating; this validator only checks
+// that the configuration is consistent with the profiles in the Databricks config file.
+func WorkspaceProfile() Validator {
+	return &workspaceProfile{}
+}
+
+func (v *workspaceProfile) Name() string {
+	return "validate:workspace_profile"
+}
+
+func (v *workspaceProfile) Validate(ctx context.Context, b *bundle.Bundle) diag.Diagnostics {
+	p := dyn.NewPath(dyn.Key("workspace"), dyn.Key("profile"))
+	pv, err := dyn.GetByPath(b.Config.Value(), p)
+	if err != nil || pv.Kind() == dyn.KindNil {
+		return nil
+	}
+
+	profile, ok := pv.AsString()
+	if !ok || strings.TrimSpace(profile) == "" {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  "profile must be a non-empty string",
+				Path:     p,
+			},
+		}
+	}
+
+	host := b.Config.Workspace.Host
+	if host == "" {
+		return nil
+	}
+
+	// If the config file cannot be loaded or doesn't include the profile,
+	// authentication reports the problem.
+	configFile, err := databrickscfg.Get(ctx)
+	if err != nil {
+		return nil
+	}
+	if _, err := configFile.GetSection(profile); err != nil {
+		return nil
+	}
+
+	cfg := &config.Config{
+		ConfigFile: configFile.Path(),
+		Host:       host,
+	}
+	err = databrickscfg.ValidateConfigAndProfileHost(cfg, profile)
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  err.Error(),
+				Path:     p,
+			},
+		}
+	}
+
+	return nil
+}
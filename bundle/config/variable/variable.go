@@ -2,6 +2,8 @@ package variable
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 )
 
 // An input variable for the bundle config
@@ -26,6 +28,9 @@ type Variable struct {
 	// The value of this field will be used to lookup the resource by name
 	// And assign the value of the variable to ID of the resource found.
 	Lookup *Lookup `json:"lookup,omitempty"`
+
+	// If set, the value of the variable must be one of these values.
+	Enum []string `json:"enum,omitempty"`
 }
 
 // True if the variable has been assigned a default value. Variables without a
@@ -43,6 +48,18 @@ func (v *Variable) Set(val string) error {
 	if v.HasValue() {
 		return fmt.Errorf("variable has already been assigned value: %s", *v.Value)
 	}
+	if err := v.Validate(val); err != nil {
+		return err
+	}
 	v.Value = &val
 	return nil
 }
+
+// Validate returns an error if the value does not satisfy the constraints
+// declared for this variable.
+func (v *Variable) Validate(val string) error {
+	if len(v.Enum) > 0 && !slices.Contains(v.Enum, val) {
+		return fmt.Errorf("expected one of %s, got %q", strings.Join(v.Enum, ", "), val)
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 
@@ -15,10 +18,13 @@ import (
 type Workspace struct {
 	// Unified authentication attributes.
 	//
-	// We omit sensitive attributes as they should never be hardcoded.
+	// We omit most sensitive attributes as they should never be hardcoded.
 	// They must be specified through environment variables instead.
 	//
-	// For example: token, password, Google credentials, Azure client secret, etc.
+	// For example: password, Google credentials, Azure client secret, etc.
+	//
+	// The token is the exception; it can only be specified as a reference to
+	// a secret in the environment, for example `${SECRET:DATABRICKS_TOKEN}`.
 	//
 
 	// Generic attributes.
@@ -26,6 +32,7 @@ type Workspace struct {
 	Profile            string `json:"profile,omitempty"`
 	AuthType           string `json:"auth_type,omitempty"`
 	MetadataServiceURL string `json:"metadata_service_url,omitempty" bundle:"internal"`
+	Token              Secret `json:"token,omitempty"`
 
 	// OAuth specific attributes.
 	ClientID string `json:"client_id,omitempty"`
@@ -54,13 +61,35 @@ type Workspace struct {
 	// This defaults to "${workspace.root}/files".
 	FilePath string `json:"file_path,omitempty"`
 
-	// Remote workspace path for build artifacts.
+	// Remote workspace path of the Git folder to check out the bundle's repository in,
+	// if the bundle is deployed from a Git folder (`bundle.deployment.source: repo`).
+	// This defaults to "${workspace.root}/repo".
+	RepoPath string `json:"repo_path,omitempty"`
+
+	// Remote path for build artifacts. It may be prefixed with a scheme
+	// to refer to a path on DBFS (`dbfs:/...`) or S3 (`s3://...`).
 	// This defaults to "${workspace.root}/artifacts".
-	ArtifactPath string `json:"artifact_path,omitempty"`
+	ArtifactPath PathLike `json:"artifact_path,omitempty"`
 
 	// Remote workspace path for deployment state.
 	// This defaults to "${workspace.root}/state".
 	StatePath string `json:"state_path,omitempty"`
+
+	// Tags to apply to all resources in the bundle that support tags.
+	// Tags defined on a resource take precedence over these.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Defaults to apply to all new job clusters in the bundle.
+	// Values defined on a cluster take precedence over these.
+	Defaults *ClusterDefaults `json:"defaults,omitempty"`
+}
+
+// ClusterDefaults defines cluster settings to use for new job clusters
+// that don't specify them.
+type ClusterDefaults struct {
+	SparkVersion string `json:"spark_version,omitempty"`
+	NodeTypeId   string `json:"node_type_id,omitempty"`
+	PolicyId     string `json:"policy_id,omitempty"`
 }
 
 type User struct {
@@ -78,6 +107,34 @@ func (s User) MarshalJSON() ([]byte, error) {
 	return marshal.Marshal(s)
 }
 
+// ErrHostNotSet is returned by [ValidateHost] if the host is empty.
+var ErrHostNotSet = errors.New("workspace host is not set; please configure workspace.host")
+
+// ValidateHost returns an error if the specified workspace host is not
+// an https:// URL pointing to the root of a workspace. The SDK accepts and
+// normalizes other forms of the host, so this is used for warnings only.
+func ValidateHost(host string) error {
+	if host == "" {
+		return ErrHostNotSet
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return fmt.Errorf("invalid workspace host %q: %w", host, err)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("invalid workspace host %q: expected a URL starting with https://", host)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid workspace host %q: missing host name", host)
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("invalid workspace host %q: expected a URL without a path, query, or fragment", host)
+	}
+
+	return nil
+}
+
 func (w *Workspace) Client() (*databricks.WorkspaceClient, error) {
 	cfg := config.Config{
 		// Generic
@@ -85,6 +142,7 @@ func (w *Workspace) Client() (*databricks.WorkspaceClient, error) {
 		Profile:            w.Profile,
 		AuthType:           w.AuthType,
 		MetadataServiceURL: w.MetadataServiceURL,
+		Token:              string(w.Token),
 
 		// OAuth
 		ClientID: w.ClientID,
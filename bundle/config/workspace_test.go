@@ -11,6 +11,7 @@ import (
 	"github.com/databricks/cli/libs/databrickscfg"
 	"github.com/databricks/databricks-sdk-go/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func setupWorkspaceTest(t *testing.T) string {
@@ -142,3 +143,29 @@ func TestWorkspaceVerifyProfileForHost(t *testing.T) {
 		assert.ErrorContains(t, err, "config host mismatch")
 	})
 }
+
+func TestValidateHost(t *testing.T) {
+	assert.NoError(t, ValidateHost("https://abc.cloud.databricks.com"))
+	assert.NoError(t, ValidateHost("https://abc.cloud.databricks.com/"))
+
+	assert.ErrorIs(t, ValidateHost(""), ErrHostNotSet)
+	assert.ErrorContains(t, ValidateHost("http://abc.cloud.databricks.com"), "expected a URL starting with https://")
+	assert.ErrorContains(t, ValidateHost("abc.cloud.databricks.com"), "expected a URL starting with https://")
+	assert.ErrorContains(t, ValidateHost("https://"), "missing host name")
+	assert.ErrorContains(t, ValidateHost("https://abc.cloud.databricks.com/foo"), "expected a URL without a path, query, or fragment")
+	assert.ErrorContains(t, ValidateHost("https://abc.cloud.databricks.com/?o=123"), "expected a URL without a path, query, or fragment")
+	assert.ErrorContains(t, ValidateHost("https://abc.cloud.databricks.com:port"), "invalid workspace host")
+}
+
+func TestWorkspaceClientWithHostThatIsNormalized(t *testing.T) {
+	setupWorkspaceTest(t)
+
+	// The SDK adds the scheme and strips the path and query.
+	w := Workspace{
+		Host: "abc.cloud.databricks.com/?o=123",
+	}
+
+	client, err := w.Client()
+	require.NoError(t, err)
+	assert.Equal(t, "https://abc.cloud.databricks.com", client.Config.CanonicalHostName())
+}
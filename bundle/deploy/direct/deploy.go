@@ -0,0 +1,563 @@
+package direct
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/auth"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/convert"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+)
+
+// resourceID identifies a resource by its type (e.g. "jobs") and key.
+type resourceID struct {
+	typ string
+	key string
+}
+
+func (id resourceID) String() string {
+	return fmt.Sprintf("resources.%s.%s", id.typ, id.key)
+}
+
+type deployStatus int
+
+const (
+	statusPending deployStatus = iota
+	statusInProgress
+	statusDone
+)
+
+// deployer creates or updates resources in dependency order. A resource that
+// refers to the ID of another resource (e.g. `${resources.pipelines.foo.id}`)
+// is deployed after the resource it refers to.
+type deployer struct {
+	w     *databricks.WorkspaceClient
+	root  dyn.Value
+	state *State
+
+	// If set, resources are updated even if their configuration is unchanged.
+	forceUpdate bool
+
+	// currentUser is made the owner of resources whose permissions
+	// do not include an owner, like Terraform does.
+	currentUser string
+
+	status map[resourceID]deployStatus
+
+	// If set, the deployer records the changes it would make in changes
+	// instead of calling the API.
+	dryRun  bool
+	changes []plannedChange
+}
+
+func (d *deployer) exists(id resourceID) bool {
+	_, err := dyn.GetByPath(d.root, dyn.NewPath(dyn.Key("resources"), dyn.Key(id.typ), dyn.Key(id.key)))
+	return err == nil
+}
+
+// resolve replaces references to other resources in the configuration of a resource.
+func (d *deployer) resolve(ctx context.Context, v dyn.Value) (dyn.Value, error) {
+	return dynvar.Resolve(v, func(path dyn.Path) (dyn.Value, error) {
+		if len(path) < 4 || path[0] != dyn.Key("resources") {
+			return dyn.InvalidValue, dynvar.ErrSkipResolution
+		}
+
+		if len(path) == 4 && path[3] == dyn.Key("id") {
+			dep := resourceID{path[1].Key(), path[2].Key()}
+			if dep.typ != "jobs" && dep.typ != "pipelines" {
+				return dyn.InvalidValue, fmt.Errorf("reference to unsupported resource type: %s", path)
+			}
+			if !d.exists(dep) {
+				return dyn.InvalidValue, fmt.Errorf("reference to undefined resource: %s", path)
+			}
+			err := d.deploy(ctx, dep)
+			if err != nil {
+				return dyn.InvalidValue, err
+			}
+			return dyn.V(d.state.entries(dep.typ)[dep.key].ID), nil
+		}
+
+		return dyn.GetByPath(d.root, path)
+	})
+}
+
+func (d *deployer) deploy(ctx context.Context, id resourceID) error {
+	switch d.status[id] {
+	case statusDone:
+		return nil
+	case statusInProgress:
+		return fmt.Errorf("cycle detected in references to %s", id)
+	}
+
+	d.status[id] = statusInProgress
+
+	v, err := dyn.GetByPath(d.root, dyn.NewPath(dyn.Key("resources"), dyn.Key(id.typ), dyn.Key(id.key)))
+	if err != nil {
+		return err
+	}
+
+	v, err = d.resolve(ctx, v)
+	if err != nil {
+		return err
+	}
+
+	var job resources.Job
+	var pipeline resources.Pipeline
+	var settings any
+	var permissions []resources.Permission
+	switch id.typ {
+	case "jobs":
+		err = convert.ToTyped(&job, v)
+		settings, permissions = job.JobSettings, job.Permissions
+	case "pipelines":
+		err = convert.ToTyped(&pipeline, v)
+		settings, permissions = pipeline.PipelineSpec, pipeline.Permissions
+	}
+	if err != nil {
+		return err
+	}
+
+	hash, err := contentHash(settings, permissions)
+	if err != nil {
+		return err
+	}
+	config, err := deployedConfig(settings, permissions)
+	if err != nil {
+		return err
+	}
+
+	// Skip resources that were deployed with the same configuration before,
+	// unless an update is forced to overwrite changes made outside of the bundle.
+	s := d.state.entries(id.typ)[id.key]
+	if s != nil && s.Hash == hash && !d.forceUpdate {
+		exists := true
+
+		// Resources that were deleted outside of the bundle are created again.
+		// Plans are computed without calling the API.
+		if !d.dryRun {
+			exists, err = resourceExists(ctx, d.w, id.typ, s.ID)
+			if err != nil {
+				return fmt.Errorf("failed to check if %s exists: %w", id, err)
+			}
+		}
+
+		if exists {
+			log.Infof(ctx, "Skipping %s (%s); its configuration is unchanged", id, s.ID)
+			d.status[id] = statusDone
+			return nil
+		}
+	}
+
+	if d.dryRun {
+		if s == nil {
+			d.changes = append(d.changes, plannedChange{action: "create", id: id})
+			d.state.entries(id.typ)[id.key] = &ResourceState{ID: unknownID}
+		} else {
+			fields, err := diffConfig(s.Config, config)
+			if err != nil {
+				return err
+			}
+			d.changes = append(d.changes, plannedChange{action: "update", id: id, fields: fields})
+		}
+		d.status[id] = statusDone
+		return nil
+	}
+
+	var objectID string
+	switch id.typ {
+	case "jobs":
+		objectID, err = d.deployJob(ctx, id.key, &job)
+	case "pipelines":
+		objectID, err = d.deployPipeline(ctx, id.key, &pipeline)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to deploy %s: %w", id, err)
+	}
+
+	// The hash is recorded once the permissions are updated as well,
+	// such that a failed update is retried on the next deployment.
+	entry := &ResourceState{ID: objectID}
+	d.state.entries(id.typ)[id.key] = entry
+
+	// Permissions that were removed from the configuration are revoked as well.
+	if len(permissions) > 0 || (s != nil && s.Permissions) {
+		err = d.setPermissions(ctx, id.typ, objectID, permissions)
+		if err != nil {
+			return fmt.Errorf("failed to update permissions of %s: %w", id, err)
+		}
+	}
+	entry.Permissions = len(permissions) > 0
+
+	entry.Hash = hash
+	entry.Config = config
+	d.status[id] = statusDone
+	return nil
+}
+
+// contentHash returns a hash of the resolved settings and permissions of a resource.
+func contentHash(settings any, permissions []resources.Permission) (string, error) {
+	raw, err := json.Marshal([]any{settings, permissions})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// deployedConfig returns the JSON representation of the resolved settings and
+// permissions of a resource. It is recorded in the state such that plans can
+// show which fields of a resource change.
+func deployedConfig(settings any, permissions []resources.Permission) (json.RawMessage, error) {
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	var config map[string]any
+	err = json.Unmarshal(raw, &config)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		config = make(map[string]any)
+	}
+	if len(permissions) > 0 {
+		config["permissions"] = permissions
+	}
+	return json.Marshal(config)
+}
+
+// diffConfig returns the changes between the configuration a resource was last
+// deployed with and its current configuration. It returns no changes if the
+// state predates the recording of configurations.
+func diffConfig(before, after json.RawMessage) ([]dyn.Change, error) {
+	if len(before) == 0 {
+		return nil, nil
+	}
+	a, err := configValue(before)
+	if err != nil {
+		return nil, err
+	}
+	b, err := configValue(after)
+	if err != nil {
+		return nil, err
+	}
+	return dyn.Diff(a, b), nil
+}
+
+func configValue(raw json.RawMessage) (dyn.Value, error) {
+	var v any
+	err := json.Unmarshal(raw, &v)
+	if err != nil {
+		return dyn.InvalidValue, err
+	}
+	return convert.FromTyped(v, dyn.NilValue)
+}
+
+func (d *deployer) deployJob(ctx context.Context, key string, job *resources.Job) (string, error) {
+	if job.JobSettings == nil {
+		job.JobSettings = &jobs.JobSettings{}
+	}
+
+	if s := d.state.entries("jobs")[key]; s != nil {
+		jobID, err := strconv.ParseInt(s.ID, 10, 64)
+		if err != nil {
+			return "", err
+		}
+
+		log.Infof(ctx, "Updating job %s (%d)", key, jobID)
+		err = d.w.Jobs.Reset(ctx, jobs.ResetJob{
+			JobId:       jobID,
+			NewSettings: *job.JobSettings,
+		})
+		if err == nil || !apierr.IsMissing(err) {
+			return s.ID, err
+		}
+
+		// The job was deleted outside of the deployment; create it again.
+		log.Warnf(ctx, "Job %s (%d) no longer exists and is created again", key, jobID)
+	}
+
+	var req jobs.CreateJob
+	err := convertSettings(job.JobSettings, &req)
+	if err != nil {
+		return "", err
+	}
+
+	log.Infof(ctx, "Creating job %s", key)
+	res, err := d.w.Jobs.Create(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(res.JobId, 10), nil
+}
+
+func (d *deployer) deployPipeline(ctx context.Context, key string, pipeline *resources.Pipeline) (string, error) {
+	if pipeline.PipelineSpec == nil {
+		pipeline.PipelineSpec = &pipelines.PipelineSpec{}
+	}
+
+	if s := d.state.entries("pipelines")[key]; s != nil {
+		var req pipelines.EditPipeline
+		err := convertSettings(pipeline.PipelineSpec, &req)
+		if err != nil {
+			return "", err
+		}
+		req.PipelineId = s.ID
+
+		log.Infof(ctx, "Updating pipeline %s (%s)", key, s.ID)
+		err = d.w.Pipelines.Update(ctx, req)
+		if err == nil || !apierr.IsMissing(err) {
+			return s.ID, err
+		}
+
+		// The pipeline was deleted outside of the deployment; create it again.
+		log.Warnf(ctx, "Pipeline %s (%s) no longer exists and is created again", key, s.ID)
+	}
+
+	var req pipelines.CreatePipeline
+	err := convertSettings(pipeline.PipelineSpec, &req)
+	if err != nil {
+		return "", err
+	}
+
+	log.Infof(ctx, "Creating pipeline %s", key)
+	res, err := d.w.Pipelines.Create(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return res.PipelineId, nil
+}
+
+// setPermissions replaces the permissions of the object with the configured permissions.
+func (d *deployer) setPermissions(ctx context.Context, typ string, objectID string, permissions []resources.Permission) error {
+	var acl []iam.AccessControlRequest
+	hasOwner := false
+	for _, p := range permissions {
+		acl = append(acl, iam.AccessControlRequest{
+			PermissionLevel:      iam.PermissionLevel(p.Level),
+			UserName:             p.UserName,
+			GroupName:            p.GroupName,
+			ServicePrincipalName: p.ServicePrincipalName,
+		})
+		hasOwner = hasOwner || p.Level == string(iam.PermissionLevelIsOwner)
+	}
+
+	if !hasOwner && d.currentUser != "" {
+		owner := iam.AccessControlRequest{PermissionLevel: iam.PermissionLevelIsOwner}
+		if auth.IsServicePrincipal(d.currentUser) {
+			owner.ServicePrincipalName = d.currentUser
+		} else {
+			owner.UserName = d.currentUser
+		}
+		acl = append(acl, owner)
+	}
+
+	_, err := d.w.Permissions.Set(ctx, iam.PermissionsRequest{
+		RequestObjectType: typ,
+		RequestObjectId:   objectID,
+		AccessControlList: acl,
+	})
+	return err
+}
+
+// convertSettings converts between the settings of a resource and
+// the request types of the API, which share their JSON representation.
+func convertSettings(src any, dst any) error {
+	raw, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, dst)
+}
+
+// deleteResource deletes a resource that is no longer part of the configuration.
+func deleteResource(ctx context.Context, w *databricks.WorkspaceClient, typ string, key string, objectID string) error {
+	var err error
+	switch typ {
+	case "jobs":
+		var jobID int64
+		jobID, err = strconv.ParseInt(objectID, 10, 64)
+		if err != nil {
+			return err
+		}
+		log.Infof(ctx, "Deleting job %s (%d)", key, jobID)
+		err = w.Jobs.Delete(ctx, jobs.DeleteJob{JobId: jobID})
+	case "pipelines":
+		log.Infof(ctx, "Deleting pipeline %s (%s)", key, objectID)
+		err = w.Pipelines.Delete(ctx, pipelines.DeletePipelineRequest{PipelineId: objectID})
+	}
+
+	// Deleting a resource that no longer exists is not an error.
+	if err != nil && !apierr.IsMissing(err) {
+		return fmt.Errorf("failed to delete resources.%s.%s: %w", typ, key, err)
+	}
+	return nil
+}
+
+// checkSupported returns an error if the configuration uses
+// features that are not supported by the direct deployment backend.
+func checkSupported(b *bundle.Bundle) error {
+	r := b.Config.Resources
+	unsupported := map[string]int{
+		"models":                  len(r.Models),
+		"experiments":             len(r.Experiments),
+		"model_serving_endpoints": len(r.ModelServingEndpoints),
+		"registered_models":       len(r.RegisteredModels),
+	}
+	for _, typ := range sortedKeys(unsupported) {
+		if unsupported[typ] > 0 {
+			return fmt.Errorf("the direct deployment backend does not support resources of type %s", typ)
+		}
+	}
+
+	if b.Config.Bundle.Deployment.FailOnActiveRuns {
+		return fmt.Errorf("the direct deployment backend does not support fail_on_active_runs")
+	}
+
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type deploy struct{}
+
+func (m *deploy) Name() string {
+	return "direct.Deploy"
+}
+
+func (m *deploy) Apply(ctx context.Context, b *bundle.Bundle) error {
+	err := checkSupported(b)
+	if err != nil {
+		return err
+	}
+
+	state, err := loadState(ctx, b)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		state = &State{}
+	}
+
+	cmdio.LogString(ctx, "Deploying resources...")
+
+	d := &deployer{
+		w:      b.WorkspaceClient(),
+		root:   b.Config.Value(),
+		state:  state,
+		status: make(map[resourceID]deployStatus),
+
+		forceUpdate: b.Config.Bundle.ForceUpdate,
+	}
+	if u := b.Config.Workspace.CurrentUser; u != nil && u.User != nil {
+		d.currentUser = u.UserName
+	}
+
+	// Record the state even if the deployment fails halfway,
+	// such that resources that were created are not orphaned.
+	err = deployAll(ctx, b, d)
+	saveErr := saveState(ctx, b, state)
+	if err != nil {
+		return err
+	}
+	if saveErr != nil {
+		return saveErr
+	}
+
+	setResourceIDs(b, state)
+	log.Infof(ctx, "Resource deployment completed")
+	return nil
+}
+
+func deployAll(ctx context.Context, b *bundle.Bundle, d *deployer) error {
+	// Deploy resources after the resources they refer to.
+	g, err := b.Config.DependencyGraph()
+	if err != nil {
+		return err
+	}
+	order, err := g.TopologicalOrder()
+	if err != nil {
+		return err
+	}
+	for _, node := range order {
+		p := dyn.MustPathFromString(node)
+		if len(p) != 3 || p[0] != dyn.Key("resources") {
+			continue
+		}
+		id := resourceID{p[1].Key(), p[2].Key()}
+		if id.typ != "jobs" && id.typ != "pipelines" {
+			continue
+		}
+		err := d.deploy(ctx, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Resources that are filtered out are retained.
+	if b.Config.Bundle.IsFiltered() {
+		return nil
+	}
+
+	for _, typ := range []string{"jobs", "pipelines"} {
+		entries := d.state.entries(typ)
+		for _, key := range sortedKeys(entries) {
+			if d.exists(resourceID{typ, key}) {
+				continue
+			}
+			if d.dryRun {
+				d.changes = append(d.changes, plannedChange{action: "delete", id: resourceID{typ, key}})
+				continue
+			}
+			err := deleteResource(ctx, d.w, typ, key, entries[key].ID)
+			if err != nil {
+				return err
+			}
+			delete(entries, key)
+		}
+	}
+
+	return nil
+}
+
+// setResourceIDs records the IDs of deployed resources in the configuration.
+func setResourceIDs(b *bundle.Bundle, state *State) {
+	for key, s := range state.Jobs {
+		if job, ok := b.Config.Resources.Jobs[key]; ok && job != nil {
+			job.ID = s.ID
+		}
+	}
+	for key, s := range state.Pipelines {
+		if pipeline, ok := b.Config.Resources.Pipelines[key]; ok && pipeline != nil {
+			pipeline.ID = s.ID
+		}
+	}
+}
+
+// Deploy returns a [bundle.Mutator] that creates, updates and deletes
+// jobs and pipelines through direct API calls.
+func Deploy() bundle.Mutator {
+	return &deploy{}
+}
@@ -0,0 +1,472 @@
+package direct
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockBundle(t *testing.T) *bundle.Bundle {
+	return &bundle.Bundle{
+		Config: config.Root{
+			Path: t.TempDir(),
+			Bundle: config.Bundle{
+				Target: "default",
+				Deployment: config.Deployment{
+					Backend: config.DeploymentBackendDirect,
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"ingest": {
+						JobSettings: &jobs.JobSettings{
+							Name: "ingest",
+							Tasks: []jobs.Task{
+								{
+									TaskKey: "refresh",
+									PipelineTask: &jobs.PipelineTask{
+										PipelineId: "${resources.pipelines.etl.id}",
+									},
+								},
+							},
+						},
+						Permissions: []resources.Permission{
+							{Level: "CAN_VIEW", GroupName: "users"},
+						},
+					},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"etl": {
+						PipelineSpec: &pipelines.PipelineSpec{Name: "etl"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDeployCreatesResourcesInDependencyOrder(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockPipelinesAPI().EXPECT().Create(mock.Anything, mock.MatchedBy(func(req pipelines.CreatePipeline) bool {
+		return req.Name == "etl"
+	})).
+		Return(&pipelines.CreatePipelineResponse{PipelineId: "pipeline-1"}, nil)
+	m.GetMockJobsAPI().EXPECT().Create(mock.Anything, mock.MatchedBy(func(req jobs.CreateJob) bool {
+		return req.Name == "ingest" && req.Tasks[0].PipelineTask.PipelineId == "pipeline-1"
+	})).Return(&jobs.CreateResponse{JobId: 123}, nil)
+	m.GetMockPermissionsAPI().EXPECT().Set(mock.Anything, iam.PermissionsRequest{
+		RequestObjectType: "jobs",
+		RequestObjectId:   "123",
+		AccessControlList: []iam.AccessControlRequest{
+			{PermissionLevel: "CAN_VIEW", GroupName: "users"},
+		},
+	}).Return(&iam.ObjectPermissions{}, nil)
+
+	err := bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	assert.Equal(t, "123", b.Config.Resources.Jobs["ingest"].ID)
+	assert.Equal(t, "pipeline-1", b.Config.Resources.Pipelines["etl"].ID)
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, 1, state.Serial)
+	assert.Equal(t, "123", state.Jobs["ingest"].ID)
+	assert.Equal(t, "pipeline-1", state.Pipelines["etl"].ID)
+	assert.NotEmpty(t, state.Jobs["ingest"].Hash)
+	assert.NotEmpty(t, state.Pipelines["etl"].Hash)
+}
+
+func TestDeploySkipsUnchangedResources(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockPipelinesAPI().EXPECT().Create(mock.Anything, mock.Anything).
+		Return(&pipelines.CreatePipelineResponse{PipelineId: "pipeline-1"}, nil).Once()
+	m.GetMockJobsAPI().EXPECT().Create(mock.Anything, mock.Anything).
+		Return(&jobs.CreateResponse{JobId: 123}, nil).Once()
+	m.GetMockPermissionsAPI().EXPECT().Set(mock.Anything, mock.Anything).
+		Return(&iam.ObjectPermissions{}, nil).Once()
+
+	err := bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	// Only the job is updated after changing its configuration.
+	// Unchanged resources are only checked for existence.
+	m.GetMockPipelinesAPI().EXPECT().GetByPipelineId(mock.Anything, "pipeline-1").Return(&pipelines.GetPipelineResponse{PipelineId: "pipeline-1"}, nil).Twice()
+	err = bundle.ApplyFunc(ctx, b, func(ctx context.Context, b *bundle.Bundle) error {
+		b.Config.Resources.Jobs["ingest"].MaxConcurrentRuns = 2
+		return nil
+	})
+	require.NoError(t, err)
+	m.GetMockJobsAPI().EXPECT().Reset(mock.Anything, mock.MatchedBy(func(req jobs.ResetJob) bool {
+		return req.JobId == 123 && req.NewSettings.MaxConcurrentRuns == 2
+	})).Return(nil).Once()
+	m.GetMockPermissionsAPI().EXPECT().Set(mock.Anything, mock.Anything).
+		Return(&iam.ObjectPermissions{}, nil).Once()
+
+	err = bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	// Nothing is updated if the configuration is unchanged.
+	m.GetMockJobsAPI().EXPECT().GetByJobId(mock.Anything, int64(123)).Return(&jobs.Job{JobId: 123}, nil).Once()
+	err = bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, 3, state.Serial)
+	assert.Equal(t, "123", b.Config.Resources.Jobs["ingest"].ID)
+}
+
+func TestDeployRecreatesUnchangedResourcesDeletedOutsideOfBundle(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	b.Config.Resources.Jobs = nil
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockPipelinesAPI().EXPECT().Create(mock.Anything, mock.Anything).
+		Return(&pipelines.CreatePipelineResponse{PipelineId: "pipeline-1"}, nil).Once()
+	err := bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	// The pipeline was deleted through the UI.
+	m.GetMockPipelinesAPI().EXPECT().GetByPipelineId(mock.Anything, "pipeline-1").
+		Return(nil, &apierr.APIError{StatusCode: 404, ErrorCode: "RESOURCE_DOES_NOT_EXIST"}).Once()
+	m.GetMockPipelinesAPI().EXPECT().Update(mock.Anything, mock.Anything).
+		Return(&apierr.APIError{StatusCode: 404, ErrorCode: "RESOURCE_DOES_NOT_EXIST"}).Once()
+	m.GetMockPipelinesAPI().EXPECT().Create(mock.Anything, mock.Anything).
+		Return(&pipelines.CreatePipelineResponse{PipelineId: "pipeline-2"}, nil).Once()
+	err = bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+	assert.Equal(t, "pipeline-2", b.Config.Resources.Pipelines["etl"].ID)
+}
+
+func TestDeployForceUpdatesUnchangedResources(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	b.Config.Resources.Jobs = nil
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockPipelinesAPI().EXPECT().Create(mock.Anything, mock.Anything).
+		Return(&pipelines.CreatePipelineResponse{PipelineId: "pipeline-1"}, nil).Once()
+	err := bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	// Changes made outside of the bundle are overwritten.
+	err = bundle.ApplyFunc(ctx, b, func(ctx context.Context, b *bundle.Bundle) error {
+		b.Config.Bundle.ForceUpdate = true
+		return nil
+	})
+	require.NoError(t, err)
+	m.GetMockPipelinesAPI().EXPECT().Update(mock.Anything, mock.MatchedBy(func(req pipelines.EditPipeline) bool {
+		return req.PipelineId == "pipeline-1" && req.Name == "etl"
+	})).Return(nil).Once()
+	err = bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+}
+
+func TestDeployRevokesRemovedPermissions(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	b.Config.Workspace.CurrentUser = &config.User{User: &iam.User{UserName: "jane@doe.com"}}
+	b.Config.Resources.Pipelines = nil
+	b.Config.Resources.Jobs["ingest"].Tasks = nil
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockJobsAPI().EXPECT().Create(mock.Anything, mock.Anything).
+		Return(&jobs.CreateResponse{JobId: 123}, nil)
+	m.GetMockPermissionsAPI().EXPECT().Set(mock.Anything, iam.PermissionsRequest{
+		RequestObjectType: "jobs",
+		RequestObjectId:   "123",
+		AccessControlList: []iam.AccessControlRequest{
+			{PermissionLevel: "CAN_VIEW", GroupName: "users"},
+			{PermissionLevel: "IS_OWNER", UserName: "jane@doe.com"},
+		},
+	}).Return(&iam.ObjectPermissions{}, nil).Once()
+
+	err := bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	// Removing the permissions from the configuration leaves only the owner.
+	err = bundle.ApplyFunc(ctx, b, func(ctx context.Context, b *bundle.Bundle) error {
+		b.Config.Resources.Jobs["ingest"].Permissions = nil
+		return nil
+	})
+	require.NoError(t, err)
+	m.GetMockJobsAPI().EXPECT().Reset(mock.Anything, mock.Anything).Return(nil).Once()
+	m.GetMockPermissionsAPI().EXPECT().Set(mock.Anything, iam.PermissionsRequest{
+		RequestObjectType: "jobs",
+		RequestObjectId:   "123",
+		AccessControlList: []iam.AccessControlRequest{
+			{PermissionLevel: "IS_OWNER", UserName: "jane@doe.com"},
+		},
+	}).Return(&iam.ObjectPermissions{}, nil).Once()
+
+	err = bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	// Permissions are no longer managed once they have been reset.
+	err = bundle.ApplyFunc(ctx, b, func(ctx context.Context, b *bundle.Bundle) error {
+		b.Config.Resources.Jobs["ingest"].MaxConcurrentRuns = 2
+		return nil
+	})
+	require.NoError(t, err)
+	m.GetMockJobsAPI().EXPECT().Reset(mock.Anything, mock.Anything).Return(nil).Once()
+
+	err = bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+}
+
+func TestDeployUpdatesAndDeletesResources(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	b.Config.Resources.Jobs = nil
+	require.NoError(t, saveState(ctx, b, &State{
+		Jobs:      map[string]*ResourceState{"ingest": {ID: "123"}},
+		Pipelines: map[string]*ResourceState{"etl": {ID: "pipeline-1"}},
+	}))
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockPipelinesAPI().EXPECT().Update(mock.Anything, mock.MatchedBy(func(req pipelines.EditPipeline) bool {
+		return req.Name == "etl" && req.PipelineId == "pipeline-1"
+	})).Return(nil)
+	m.GetMockJobsAPI().EXPECT().Delete(mock.Anything, jobs.DeleteJob{JobId: 123}).Return(nil)
+
+	err := bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, 2, state.Serial)
+	assert.Empty(t, state.Jobs)
+	assert.Equal(t, "pipeline-1", state.Pipelines["etl"].ID)
+}
+
+func TestDeployRetainsFilteredResources(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	b.Config.Bundle.Only = []string{"pipeline:etl"}
+	b.Config.Resources.Jobs = nil
+	require.NoError(t, saveState(ctx, b, &State{
+		Jobs:      map[string]*ResourceState{"ingest": {ID: "123"}},
+		Pipelines: map[string]*ResourceState{"etl": {ID: "pipeline-1"}},
+	}))
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockPipelinesAPI().EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+	err := bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*ResourceState{"ingest": {ID: "123"}}, state.Jobs)
+}
+
+func TestDeployRecreatesMissingJob(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	b.Config.Resources.Pipelines = nil
+	b.Config.Resources.Jobs["ingest"].Tasks = nil
+	b.Config.Resources.Jobs["ingest"].Permissions = nil
+	require.NoError(t, saveState(ctx, b, &State{
+		Jobs: map[string]*ResourceState{"ingest": {ID: "123"}},
+	}))
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockJobsAPI().EXPECT().Reset(mock.Anything, mock.Anything).
+		Return(&apierr.APIError{StatusCode: 404, ErrorCode: "RESOURCE_DOES_NOT_EXIST"})
+	m.GetMockJobsAPI().EXPECT().Create(mock.Anything, mock.MatchedBy(func(req jobs.CreateJob) bool {
+		return req.Name == "ingest"
+	})).
+		Return(&jobs.CreateResponse{JobId: 456}, nil)
+
+	err := bundle.Apply(ctx, b, Deploy())
+	require.NoError(t, err)
+	assert.Equal(t, "456", b.Config.Resources.Jobs["ingest"].ID)
+}
+
+func TestDeployRecordsStateOnFailure(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockPipelinesAPI().EXPECT().Create(mock.Anything, mock.Anything).
+		Return(&pipelines.CreatePipelineResponse{PipelineId: "pipeline-1"}, nil)
+	m.GetMockJobsAPI().EXPECT().Create(mock.Anything, mock.Anything).
+		Return(nil, &apierr.APIError{StatusCode: 400, Message: "invalid job"})
+
+	err := bundle.Apply(ctx, b, Deploy())
+	assert.EqualError(t, err, "failed to deploy resources.jobs.ingest: invalid job")
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, "pipeline-1", state.Pipelines["etl"].ID)
+}
+
+func TestDeployFailsOnReferenceCycle(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	b.Config.Resources.Pipelines["etl"].Catalog = "${resources.jobs.ingest.name}"
+
+	// The mock fails the test on any API call.
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	err := bundle.Apply(ctx, b, Deploy())
+	assert.EqualError(t, err, "dependency cycle detected: resources.jobs.ingest -> resources.pipelines.etl -> resources.jobs.ingest")
+}
+
+func TestDeployUnsupportedResources(t *testing.T) {
+	b := mockBundle(t)
+	b.Config.Resources.Experiments = map[string]*resources.MlflowExperiment{
+		"experiment": {Experiment: &ml.Experiment{Name: "experiment"}},
+	}
+
+	err := bundle.Apply(context.Background(), b, Deploy())
+	assert.EqualError(t, err, "the direct deployment backend does not support resources of type experiments")
+}
+
+func TestDestroy(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	b.AutoApprove = true
+	require.NoError(t, saveState(ctx, b, &State{
+		Jobs:      map[string]*ResourceState{"ingest": {ID: "123"}},
+		Pipelines: map[string]*ResourceState{"etl": {ID: "pipeline-1"}},
+	}))
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockJobsAPI().EXPECT().GetByJobId(mock.Anything, int64(123)).Return(&jobs.Job{}, nil)
+	m.GetMockPipelinesAPI().EXPECT().GetByPipelineId(mock.Anything, "pipeline-1").Return(&pipelines.GetPipelineResponse{}, nil)
+	m.GetMockJobsAPI().EXPECT().Delete(mock.Anything, jobs.DeleteJob{JobId: 123}).Return(nil)
+	m.GetMockPipelinesAPI().EXPECT().Delete(mock.Anything, pipelines.DeletePipelineRequest{PipelineId: "pipeline-1"}).
+		Return(&apierr.APIError{StatusCode: 404, ErrorCode: "RESOURCE_DOES_NOT_EXIST"})
+
+	err := bundle.Apply(ctx, b, Destroy())
+	require.NoError(t, err)
+	assert.True(t, b.Plan.ConfirmApply)
+	assert.False(t, b.Plan.IsEmpty)
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Empty(t, state.Jobs)
+	assert.Empty(t, state.Pipelines)
+}
+
+func TestDestroyDeclined(t *testing.T) {
+	b := mockBundle(t)
+	require.NoError(t, saveState(context.Background(), b, &State{
+		Jobs: map[string]*ResourceState{"ingest": {ID: "123"}},
+	}))
+
+	var out bytes.Buffer
+	ctx := cmdio.NewContext(context.Background(), &cmdio.Logger{
+		Mode:   flags.ModeAppend,
+		Reader: *bufio.NewReader(strings.NewReader("n\n")),
+		Writer: &out,
+	})
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	m.GetMockJobsAPI().EXPECT().GetByJobId(mock.Anything, int64(123)).Return(&jobs.Job{}, nil)
+
+	err := bundle.Apply(ctx, b, Destroy())
+	require.NoError(t, err)
+	assert.False(t, b.Plan.ConfirmApply)
+
+	// The state is neither changed nor written.
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, 1, state.Serial)
+	assert.Equal(t, "123", state.Jobs["ingest"].ID)
+}
+
+func TestDestroyRemovesOrphanedResourcesFromState(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	b.AutoApprove = true
+	require.NoError(t, saveState(ctx, b, &State{
+		Jobs: map[string]*ResourceState{"ingest": {ID: "123"}},
+	}))
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	// The job is not deleted because it no longer exists.
+	m.GetMockJobsAPI().EXPECT().GetByJobId(mock.Anything, int64(123)).
+		Return(nil, &apierr.APIError{StatusCode: 404, ErrorCode: "RESOURCE_DOES_NOT_EXIST"})
+
+	err := bundle.Apply(ctx, b, Destroy())
+	require.NoError(t, err)
+	assert.True(t, b.Plan.IsEmpty)
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Empty(t, state.Jobs)
+}
+
+func TestDestroyWithoutState(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+
+	err := bundle.Apply(ctx, b, Destroy())
+	require.NoError(t, err)
+	assert.True(t, b.Plan.IsEmpty)
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+
+	err := bundle.Apply(ctx, b, Load())
+	assert.ErrorContains(t, err, "no deployment state")
+
+	require.NoError(t, saveState(ctx, b, &State{
+		Jobs: map[string]*ResourceState{"ingest": {ID: "123"}},
+	}))
+	err = bundle.Apply(ctx, b, Load())
+	require.NoError(t, err)
+	assert.Equal(t, "123", b.Config.Resources.Jobs["ingest"].ID)
+}
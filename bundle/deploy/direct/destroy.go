@@ -0,0 +1,137 @@
+package direct
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/terraform"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/fatih/color"
+)
+
+type destroy struct{}
+
+func (m *destroy) Name() string {
+	return "direct.Destroy"
+}
+
+func (m *destroy) Apply(ctx context.Context, b *bundle.Bundle) error {
+	state, err := loadState(ctx, b)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		b.Plan = &terraform.Plan{ConfirmApply: b.AutoApprove, IsEmpty: true}
+		cmdio.LogString(ctx, "No resources to destroy")
+		return nil
+	}
+
+	// Resources that were deleted outside of the bundle are only removed from the state.
+	w := b.WorkspaceClient()
+	var present, orphaned []resourceID
+	for _, typ := range []string{"jobs", "pipelines"} {
+		entries := state.entries(typ)
+		for _, key := range sortedKeys(entries) {
+			id := resourceID{typ, key}
+			exists, err := resourceExists(ctx, w, typ, entries[key].ID)
+			if err != nil {
+				return fmt.Errorf("failed to get %s: %w", id, err)
+			}
+			if exists {
+				present = append(present, id)
+			} else {
+				orphaned = append(orphaned, id)
+			}
+		}
+	}
+
+	if len(orphaned) > 0 {
+		cmdio.LogString(ctx, "The following resources were already deleted outside of the bundle:")
+		for _, id := range orphaned {
+			cmdio.LogString(ctx, fmt.Sprintf("  %s %s", strings.TrimSuffix(id.typ, "s"), id.key))
+			delete(state.entries(id.typ), id.key)
+		}
+	}
+
+	// Files are deleted by a later mutator that checks for consent in the plan.
+	b.Plan = &terraform.Plan{
+		ConfirmApply: b.AutoApprove,
+		IsEmpty:      len(present) == 0,
+	}
+
+	if len(present) == 0 {
+		cmdio.LogString(ctx, "No resources to destroy")
+	} else {
+		cmdio.LogString(ctx, "The following resources will be removed:")
+		for _, id := range present {
+			cmdio.LogString(ctx, "  "+plannedChange{action: "delete", id: id}.String())
+		}
+
+		if !b.Plan.ConfirmApply {
+			b.Plan.ConfirmApply, err = cmdio.AskYesOrNo(ctx, fmt.Sprintf("\nThis will permanently %s resources! Proceed?", cmdio.Sprintf(ctx, color.FgRed, "destroy")))
+			if err != nil {
+				return err
+			}
+		}
+
+		// Leave the resources and the state untouched if confirmation was not provided.
+		if !b.Plan.ConfirmApply {
+			return nil
+		}
+	}
+
+	for _, id := range present {
+		entries := state.entries(id.typ)
+		err = deleteResource(ctx, w, id.typ, id.key, entries[id.key].ID)
+		if err != nil {
+			break
+		}
+		delete(entries, id.key)
+	}
+
+	// Record the state even if destroying fails halfway,
+	// such that deleted resources are no longer tracked.
+	saveErr := saveState(ctx, b, state)
+	if err != nil {
+		return err
+	}
+	if saveErr != nil {
+		return saveErr
+	}
+
+	log.Infof(ctx, "Successfully destroyed resources")
+	return nil
+}
+
+// resourceExists returns false if the resource with the specified ID no longer exists.
+func resourceExists(ctx context.Context, w *databricks.WorkspaceClient, typ string, objectID string) (bool, error) {
+	var err error
+	switch typ {
+	case "jobs":
+		var jobID int64
+		jobID, err = strconv.ParseInt(objectID, 10, 64)
+		if err != nil {
+			return false, err
+		}
+		_, err = w.Jobs.GetByJobId(ctx, jobID)
+	case "pipelines":
+		_, err = w.Pipelines.GetByPipelineId(ctx, objectID)
+	}
+	if apierr.IsMissing(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Destroy returns a [bundle.Mutator] that deletes all resources recorded in the state
+// after asking for confirmation, unless auto-approve is set. Resources that were deleted
+// outside of the bundle are reported and removed from the state.
+func Destroy() bundle.Mutator {
+	return &destroy{}
+}
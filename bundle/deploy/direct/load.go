@@ -0,0 +1,33 @@
+package direct
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+)
+
+type load struct{}
+
+func (m *load) Name() string {
+	return "direct.Load"
+}
+
+func (m *load) Apply(ctx context.Context, b *bundle.Bundle) error {
+	state, err := loadState(ctx, b)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return fmt.Errorf("no deployment state. Did you forget to run 'databricks bundle deploy'?")
+	}
+
+	setResourceIDs(b, state)
+	return nil
+}
+
+// Load returns a [bundle.Mutator] that records the IDs of deployed resources
+// in the configuration. It is an error if there is no deployment state.
+func Load() bundle.Mutator {
+	return &load{}
+}
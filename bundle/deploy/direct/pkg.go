@@ -0,0 +1,17 @@
+// Package direct implements a deployment backend that creates, updates and
+// deletes resources through direct API calls instead of through Terraform.
+//
+// It is selected with `bundle.deployment.backend: direct` and supports jobs
+// and pipelines. The identifiers of deployed resources are recorded in a
+// state file that is stored alongside the Terraform state in the workspace.
+package direct
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+)
+
+// IsEnabled returns true if the bundle is configured to use the direct deployment backend.
+func IsEnabled(b *bundle.Bundle) bool {
+	return b.Config.Bundle.Deployment.Backend == config.DeploymentBackendDirect
+}
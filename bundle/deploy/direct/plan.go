@@ -0,0 +1,108 @@
+package direct
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/dyn"
+)
+
+// unknownID is used in place of the ID of a resource that is yet to be created.
+const unknownID = "(known after deploy)"
+
+// plannedChange is a change that [Deploy] would make to a resource.
+type plannedChange struct {
+	action string
+	id     resourceID
+
+	// fields lists the changed fields of an updated resource.
+	fields []dyn.Change
+}
+
+func (c plannedChange) String() string {
+	return fmt.Sprintf("%s %s %s", c.action, strings.TrimSuffix(c.id.typ, "s"), c.id.key)
+}
+
+type plan struct{}
+
+func (m *plan) Name() string {
+	return "direct.Plan"
+}
+
+func (m *plan) Apply(ctx context.Context, b *bundle.Bundle) error {
+	err := checkSupported(b)
+	if err != nil {
+		return err
+	}
+
+	state, err := loadState(ctx, b)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		state = &State{}
+	}
+
+	d := &deployer{
+		root:   b.Config.Value(),
+		state:  state,
+		status: make(map[resourceID]deployStatus),
+		dryRun: true,
+	}
+
+	err = deployAll(ctx, b, d)
+	if err != nil {
+		return err
+	}
+
+	if len(d.changes) == 0 {
+		cmdio.LogString(ctx, "No changes to resources")
+		return nil
+	}
+
+	cmdio.LogString(ctx, "The following resources will be changed:")
+	for _, c := range d.changes {
+		cmdio.LogString(ctx, "  "+c.String())
+		for _, line := range describeFieldChanges(c.fields) {
+			cmdio.LogString(ctx, line)
+		}
+	}
+	return nil
+}
+
+// describeFieldChanges returns a line for every changed field of a resource.
+func describeFieldChanges(changes []dyn.Change) []string {
+	var lines []string
+	for _, change := range changes {
+		switch {
+		case !change.Before.IsValid():
+			lines = append(lines, fmt.Sprintf("      + %s: %s", change.Path, formatValue(change.After)))
+		case !change.After.IsValid():
+			lines = append(lines, fmt.Sprintf("      - %s: %s", change.Path, formatValue(change.Before)))
+		default:
+			lines = append(lines, fmt.Sprintf("      ~ %s: %s -> %s", change.Path, formatValue(change.Before), formatValue(change.After)))
+		}
+	}
+	return lines
+}
+
+func formatValue(v dyn.Value) string {
+	if !v.IsValid() {
+		return "null"
+	}
+	buf, err := json.Marshal(v.AsAny())
+	if err != nil {
+		return fmt.Sprint(v.AsAny())
+	}
+	return string(buf)
+}
+
+// Plan returns a [bundle.Mutator] that prints the jobs and pipelines that
+// [Deploy] would create, update and delete, without making any changes.
+func Plan() bundle.Mutator {
+	return &plan{}
+}
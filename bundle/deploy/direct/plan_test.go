@@ -0,0 +1,104 @@
+package direct
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanDoesNotCallTheAPI(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+
+	// The pipeline was deployed with a different configuration and
+	// a job that is no longer configured exists.
+	require.NoError(t, saveState(ctx, b, &State{
+		Jobs:      map[string]*ResourceState{"old": {ID: "456", Hash: "abc"}},
+		Pipelines: map[string]*ResourceState{"etl": {ID: "pipeline-1", Hash: "abc"}},
+	}))
+
+	// The mock fails the test on any unexpected API call.
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	var d *deployer
+	err := bundle.ApplyFunc(ctx, b, func(ctx context.Context, b *bundle.Bundle) error {
+		state, err := loadState(ctx, b)
+		require.NoError(t, err)
+		d = &deployer{
+			root:   b.Config.Value(),
+			state:  state,
+			status: make(map[resourceID]deployStatus),
+			dryRun: true,
+		}
+		return deployAll(ctx, b, d)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []plannedChange{
+		{action: "update", id: resourceID{"pipelines", "etl"}},
+		{action: "create", id: resourceID{"jobs", "ingest"}},
+		{action: "delete", id: resourceID{"jobs", "old"}},
+	}, d.changes)
+
+	err = bundle.Apply(ctx, b, Plan())
+	require.NoError(t, err)
+
+	// The state is left as is.
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, 1, state.Serial)
+	assert.Len(t, state.Jobs, 1)
+}
+
+func TestPlanDescribesChangedFields(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+
+	// The pipeline was deployed with a different name and a setting
+	// that has since been removed from the configuration.
+	require.NoError(t, saveState(ctx, b, &State{
+		Pipelines: map[string]*ResourceState{"etl": {
+			ID:     "pipeline-1",
+			Hash:   "abc",
+			Config: json.RawMessage(`{"name":"old","photon":true}`),
+		}},
+	}))
+
+	var d *deployer
+	err := bundle.ApplyFunc(ctx, b, func(ctx context.Context, b *bundle.Bundle) error {
+		state, err := loadState(ctx, b)
+		require.NoError(t, err)
+		d = &deployer{
+			root:   b.Config.Value(),
+			state:  state,
+			status: make(map[resourceID]deployStatus),
+			dryRun: true,
+		}
+		return deployAll(ctx, b, d)
+	})
+	require.NoError(t, err)
+	require.Equal(t, "update", d.changes[0].action)
+	assert.Equal(t, []string{
+		`      ~ name: "old" -> "etl"`,
+		`      - photon: true`,
+	}, describeFieldChanges(d.changes[0].fields))
+}
+
+func TestDeployRecordsConfig(t *testing.T) {
+	config, err := deployedConfig(&pipelines.PipelineSpec{Name: "etl"}, []resources.Permission{
+		{Level: "CAN_VIEW", GroupName: "users"},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"etl","permissions":[{"level":"CAN_VIEW","group_name":"users"}]}`, string(config))
+
+	changes, err := diffConfig(config, config)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,116 @@
+package direct
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+)
+
+// StateFileName is the name of the file that records deployed resources.
+const StateFileName = "resources.json"
+
+// ResourceState records a single deployed resource.
+type ResourceState struct {
+	ID string `json:"id"`
+
+	// Hash of the configuration the resource was last deployed with.
+	// Resources whose configuration hasn't changed are not updated.
+	Hash string `json:"hash,omitempty"`
+
+	// Config is the resolved configuration the resource was last deployed with.
+	// Plans compare it with the current configuration to show changed fields.
+	Config json.RawMessage `json:"config,omitempty"`
+
+	// Permissions is set if the permissions of the resource were set by the deployment.
+	// The permissions are reset when they are removed from the configuration.
+	Permissions bool `json:"permissions,omitempty"`
+}
+
+// State records the resources deployed by the direct deployment backend.
+type State struct {
+	// Serial is incremented on every write of the state.
+	// It is used to determine if the local state is stale, and to detect
+	// concurrent deployments when the state is pushed to the workspace.
+	Serial int `json:"serial"`
+
+	Jobs      map[string]*ResourceState `json:"jobs,omitempty"`
+	Pipelines map[string]*ResourceState `json:"pipelines,omitempty"`
+}
+
+func (s *State) entries(typ string) map[string]*ResourceState {
+	switch typ {
+	case "jobs":
+		if s.Jobs == nil {
+			s.Jobs = make(map[string]*ResourceState)
+		}
+		return s.Jobs
+	case "pipelines":
+		if s.Pipelines == nil {
+			s.Pipelines = make(map[string]*ResourceState)
+		}
+		return s.Pipelines
+	}
+	panic("unsupported resource type: " + typ)
+}
+
+// Dir returns the local directory for the state of the direct deployment backend.
+func Dir(ctx context.Context, b *bundle.Bundle) (string, error) {
+	return b.CacheDir(ctx, "direct")
+}
+
+func localStatePath(ctx context.Context, b *bundle.Bundle) (string, error) {
+	dir, err := Dir(ctx, b)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, StateFileName), nil
+}
+
+// parseState parses the contents of a state file.
+func parseState(raw []byte) (*State, error) {
+	var state State
+	err := json.Unmarshal(raw, &state)
+	if err != nil {
+		return nil, fmt.Errorf("malformed deployment state: %w", err)
+	}
+	return &state, nil
+}
+
+// loadState reads the local state file. It returns nil if it doesn't exist.
+func loadState(ctx context.Context, b *bundle.Bundle) (*State, error) {
+	path, err := localStatePath(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseState(raw)
+}
+
+// saveState increments the serial of the state and writes it to the local state file.
+func saveState(ctx context.Context, b *bundle.Bundle, state *State) error {
+	path, err := localStatePath(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	state.Serial++
+	raw, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, raw, 0600)
+}
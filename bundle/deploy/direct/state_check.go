@@ -0,0 +1,85 @@
+package direct
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/filer"
+)
+
+type stateCheck struct {
+	filerFunc
+}
+
+func (l *stateCheck) Name() string {
+	return "direct:state-check"
+}
+
+func (l *stateCheck) Apply(ctx context.Context, b *bundle.Bundle) error {
+	f, err := l.filerFunc(b)
+	if err != nil {
+		return err
+	}
+
+	local, err := loadState(ctx, b)
+	if err != nil {
+		return err
+	}
+	serial := 0
+	if local != nil {
+		serial = local.Serial
+	}
+
+	// The local state was pulled from the workspace. If the state in the workspace
+	// is newer, a concurrent deployment wrote it after it was pulled.
+	remote, err := remoteSerial(ctx, f)
+	if err != nil {
+		return err
+	}
+	if remote > serial {
+		return errConcurrentDeployment(remote, serial)
+	}
+	return nil
+}
+
+// StateCheck returns a [bundle.Mutator] that checks that the state in the workspace
+// was not updated since it was pulled. It runs before resources are changed, such that
+// a concurrent deployment is detected before it can result in changed or orphaned resources.
+func StateCheck() bundle.Mutator {
+	return &stateCheck{stateFiler}
+}
+
+// readRemoteState returns the contents of the state file in the workspace, or nil if there is none.
+func readRemoteState(ctx context.Context, f filer.Filer) ([]byte, error) {
+	remote, err := f.Read(ctx, StateFileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer remote.Close()
+	return io.ReadAll(remote)
+}
+
+// remoteSerial returns the serial of the state in the workspace, or 0 if there is none.
+func remoteSerial(ctx context.Context, f filer.Filer) (int, error) {
+	raw, err := readRemoteState(ctx, f)
+	if err != nil || raw == nil {
+		return 0, err
+	}
+
+	state, err := parseState(raw)
+	if err != nil {
+		return 0, err
+	}
+	return state.Serial, nil
+}
+
+func errConcurrentDeployment(remote, local int) error {
+	return fmt.Errorf("deployment state in the workspace was updated by another deployment (remote serial %d, local serial %d)", remote, local)
+}
@@ -0,0 +1,71 @@
+package direct
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/log"
+)
+
+type statePull struct {
+	filerFunc
+}
+
+func (l *statePull) Name() string {
+	return "direct:state-pull"
+}
+
+func (l *statePull) Apply(ctx context.Context, b *bundle.Bundle) error {
+	f, err := l.filerFunc(b)
+	if err != nil {
+		return err
+	}
+
+	log.Infof(ctx, "Opening remote state file")
+	remote, err := f.Read(ctx, StateFileName)
+	if err != nil {
+		// On first deploy this state file doesn't yet exist.
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Infof(ctx, "Remote state file does not exist")
+			return nil
+		}
+		return err
+	}
+	defer remote.Close()
+
+	raw, err := io.ReadAll(remote)
+	if err != nil {
+		return err
+	}
+
+	remoteState, err := parseState(raw)
+	if err != nil {
+		return err
+	}
+
+	local, err := loadState(ctx, b)
+	if err != nil {
+		return err
+	}
+	if local != nil && local.Serial >= remoteState.Serial {
+		log.Infof(ctx, "Local state is the same or newer, ignoring remote state")
+		return nil
+	}
+
+	log.Infof(ctx, "Writing remote state file to local cache directory")
+	path, err := localStatePath(ctx, b)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, raw, 0600)
+}
+
+// StatePull returns a [bundle.Mutator] that downloads the state file
+// from the workspace if it is newer than the local state file.
+func StatePull() bundle.Mutator {
+	return &statePull{stateFiler}
+}
@@ -0,0 +1,82 @@
+package direct
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+)
+
+type statePush struct {
+	filerFunc
+}
+
+func (l *statePush) Name() string {
+	return "direct:state-push"
+}
+
+func (l *statePush) Apply(ctx context.Context, b *bundle.Bundle) error {
+	f, err := l.filerFunc(b)
+	if err != nil {
+		return err
+	}
+
+	path, err := localStatePath(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Infof(ctx, "Local state file does not exist")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	local, err := parseState(raw)
+	if err != nil {
+		return err
+	}
+
+	// The local state is based on the state that was pulled before deploying and
+	// has a higher serial. If the state in the workspace has caught up in the meantime,
+	// it was written by a concurrent deployment and must not be overwritten.
+	remote, err := readRemoteState(ctx, f)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(remote, raw) {
+		log.Infof(ctx, "Local state is identical to remote state, skipping upload")
+		return nil
+	}
+
+	serial := 0
+	if remote != nil {
+		state, err := parseState(remote)
+		if err != nil {
+			return err
+		}
+		serial = state.Serial
+	}
+	if serial >= local.Serial {
+		return errConcurrentDeployment(serial, local.Serial)
+	}
+
+	cmdio.LogString(ctx, "Updating deployment state...")
+	log.Infof(ctx, "Writing local state file to remote state directory")
+	return f.Write(ctx, StateFileName, bytes.NewReader(raw), filer.CreateParentDirectories, filer.OverwriteIfExists)
+}
+
+// StatePush returns a [bundle.Mutator] that uploads the local state file to the workspace.
+// It is an error if the state in the workspace was updated since it was pulled.
+func StatePush() bundle.Mutator {
+	return &statePush{stateFiler}
+}
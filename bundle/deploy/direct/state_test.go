@@ -0,0 +1,196 @@
+package direct
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	mockfiler "github.com/databricks/cli/internal/mocks/libs/filer"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// identityFiler returns a filerFunc that returns the specified filer.
+func identityFiler(f filer.Filer) filerFunc {
+	return func(_ *bundle.Bundle) (filer.Filer, error) {
+		return f, nil
+	}
+}
+
+func mockStateFilerForPull(t *testing.T, state *State, merr error) filer.Filer {
+	buf, err := json.Marshal(state)
+	require.NoError(t, err)
+
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, StateFileName).
+		Return(io.NopCloser(bytes.NewReader(buf)), merr).
+		Times(1)
+	return f
+}
+
+func TestStatePullRemoteMissing(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+
+	m := &statePull{identityFiler(mockStateFilerForPull(t, nil, os.ErrNotExist))}
+	err := bundle.Apply(ctx, b, m)
+	require.NoError(t, err)
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestStatePullRemoteNewer(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	require.NoError(t, saveState(ctx, b, &State{}))
+
+	remote := &State{Serial: 5, Jobs: map[string]*ResourceState{"ingest": {ID: "123"}}}
+	m := &statePull{identityFiler(mockStateFilerForPull(t, remote, nil))}
+	err := bundle.Apply(ctx, b, m)
+	require.NoError(t, err)
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, remote, state)
+}
+
+func TestStatePullLocalNewer(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	local := &State{Serial: 5, Jobs: map[string]*ResourceState{"ingest": {ID: "123"}}}
+	require.NoError(t, saveState(ctx, b, local))
+
+	m := &statePull{identityFiler(mockStateFilerForPull(t, &State{Serial: 3}, nil))}
+	err := bundle.Apply(ctx, b, m)
+	require.NoError(t, err)
+
+	state, err := loadState(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, 6, state.Serial)
+	assert.Equal(t, local.Jobs, state.Jobs)
+}
+
+func TestStatePush(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	require.NoError(t, saveState(ctx, b, &State{Serial: 3}))
+
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, StateFileName).
+		Return(io.NopCloser(bytes.NewReader([]byte(`{"serial": 3}`))), nil)
+	f.EXPECT().
+		Write(mock.Anything, StateFileName, mock.Anything, filer.CreateParentDirectories, filer.OverwriteIfExists).
+		RunAndReturn(func(ctx context.Context, path string, r io.Reader, mode ...filer.WriteMode) error {
+			raw, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Contains(t, string(raw), `"serial": 4`)
+			return nil
+		})
+
+	err := bundle.Apply(ctx, b, &statePush{identityFiler(f)})
+	require.NoError(t, err)
+}
+
+func TestStatePushRemoteMissing(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	require.NoError(t, saveState(ctx, b, &State{}))
+
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, StateFileName).
+		Return(nil, os.ErrNotExist)
+	f.EXPECT().
+		Write(mock.Anything, StateFileName, mock.Anything, filer.CreateParentDirectories, filer.OverwriteIfExists).
+		Return(nil)
+
+	err := bundle.Apply(ctx, b, &statePush{identityFiler(f)})
+	require.NoError(t, err)
+}
+
+func TestStatePushConcurrentDeployment(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	require.NoError(t, saveState(ctx, b, &State{Serial: 3}))
+
+	// Another deployment based on the same state pushed first.
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, StateFileName).
+		Return(io.NopCloser(bytes.NewReader([]byte(`{"serial": 4}`))), nil)
+
+	err := bundle.Apply(ctx, b, &statePush{identityFiler(f)})
+	assert.EqualError(t, err, "deployment state in the workspace was updated by another deployment (remote serial 4, local serial 4)")
+}
+
+func TestStateCheck(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	require.NoError(t, saveState(ctx, b, &State{Serial: 3}))
+
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, StateFileName).
+		Return(io.NopCloser(bytes.NewReader([]byte(`{"serial": 4}`))), nil)
+
+	err := bundle.Apply(ctx, b, &stateCheck{identityFiler(f)})
+	require.NoError(t, err)
+}
+
+func TestStateCheckConcurrentDeployment(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	require.NoError(t, saveState(ctx, b, &State{Serial: 3}))
+
+	// Another deployment pushed its state after the state was pulled.
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, StateFileName).
+		Return(io.NopCloser(bytes.NewReader([]byte(`{"serial": 5}`))), nil)
+
+	err := bundle.Apply(ctx, b, &stateCheck{identityFiler(f)})
+	assert.EqualError(t, err, "deployment state in the workspace was updated by another deployment (remote serial 5, local serial 4)")
+}
+
+func TestStateCheckWithoutState(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, StateFileName).
+		Return(nil, os.ErrNotExist)
+
+	err := bundle.Apply(ctx, b, &stateCheck{identityFiler(f)})
+	require.NoError(t, err)
+}
+
+func TestStatePushUnchanged(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle(t)
+	require.NoError(t, saveState(ctx, b, &State{Serial: 3}))
+
+	path, err := localStatePath(ctx, b)
+	require.NoError(t, err)
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// The local state is the state that was pulled, so there is nothing to push.
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, StateFileName).
+		Return(io.NopCloser(bytes.NewReader(raw)), nil)
+
+	err = bundle.Apply(ctx, b, &statePush{identityFiler(f)})
+	require.NoError(t, err)
+}
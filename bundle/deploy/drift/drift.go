@@ -0,0 +1,213 @@
+// Package drift detects changes that were made to deployed resources outside of the bundle,
+// for example through the UI, by comparing their settings in the workspace to the configuration.
+package drift
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/convert"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// Field is a field whose value in the workspace differs from its value in the configuration.
+// Bundle is nil if the field is not set in the configuration, and Workspace is nil if the
+// field is not set in the workspace.
+type Field struct {
+	Path      string `json:"path"`
+	Bundle    any    `json:"bundle,omitempty"`
+	Workspace any    `json:"workspace,omitempty"`
+}
+
+// Resource is a deployed resource that was changed or deleted outside of the bundle.
+type Resource struct {
+	// Type is the type of the resource, either "job" or "pipeline".
+	Type string `json:"type"`
+	Key  string `json:"key"`
+	ID   string `json:"id"`
+
+	Deleted bool    `json:"deleted,omitempty"`
+	Fields  []Field `json:"fields,omitempty"`
+}
+
+// Detect compares the settings of the deployed jobs and pipelines in the workspace to the
+// configuration. The IDs of deployed resources must have been loaded into the configuration.
+//
+// Fields that are only set in the workspace are ignored because the workspace sets defaults
+// for many fields that are not configured, with the exception of list elements, such as
+// tasks that were added to a job.
+func Detect(ctx context.Context, b *bundle.Bundle) ([]Resource, error) {
+	w := b.WorkspaceClient()
+	r := b.Config.Resources
+
+	var out []Resource
+	for _, key := range sortedKeys(r.Jobs) {
+		job := r.Jobs[key]
+		if job == nil || job.ID == "" || job.JobSettings == nil {
+			continue
+		}
+
+		jobID, err := strconv.ParseInt(job.ID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID for job %s: %w", key, err)
+		}
+
+		remote, err := w.Jobs.GetByJobId(ctx, jobID)
+		if apierr.IsMissing(err) {
+			out = append(out, Resource{Type: "job", Key: key, ID: job.ID, Deleted: true})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get job %s: %w", key, err)
+		}
+
+		var remoteSettings jobs.JobSettings
+		if remote.Settings != nil {
+			remoteSettings = *remote.Settings
+		}
+
+		fields, err := compare(b, normalizeJobSettings(*job.JobSettings), normalizeJobSettings(remoteSettings))
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare job %s: %w", key, err)
+		}
+		if len(fields) > 0 {
+			out = append(out, Resource{Type: "job", Key: key, ID: job.ID, Fields: fields})
+		}
+	}
+
+	for _, key := range sortedKeys(r.Pipelines) {
+		pipeline := r.Pipelines[key]
+		if pipeline == nil || pipeline.ID == "" || pipeline.PipelineSpec == nil {
+			continue
+		}
+
+		remote, err := w.Pipelines.GetByPipelineId(ctx, pipeline.ID)
+		if apierr.IsMissing(err) {
+			out = append(out, Resource{Type: "pipeline", Key: key, ID: pipeline.ID, Deleted: true})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pipeline %s: %w", key, err)
+		}
+
+		fields, err := compare(b, pipeline.PipelineSpec, remote.Spec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare pipeline %s: %w", key, err)
+		}
+		if len(fields) > 0 {
+			out = append(out, Resource{Type: "pipeline", Key: key, ID: pipeline.ID, Fields: fields})
+		}
+	}
+
+	return out, nil
+}
+
+// normalizeJobSettings orders tasks and job clusters by their keys,
+// such that their order in the workspace does not matter.
+func normalizeJobSettings(settings jobs.JobSettings) jobs.JobSettings {
+	settings.Tasks = slices.Clone(settings.Tasks)
+	slices.SortStableFunc(settings.Tasks, func(a, b jobs.Task) int {
+		return cmp.Compare(a.TaskKey, b.TaskKey)
+	})
+	settings.JobClusters = slices.Clone(settings.JobClusters)
+	slices.SortStableFunc(settings.JobClusters, func(a, b jobs.JobCluster) int {
+		return cmp.Compare(a.JobClusterKey, b.JobClusterKey)
+	})
+	return settings
+}
+
+func compare(b *bundle.Bundle, local any, remote any) ([]Field, error) {
+	lv, err := convert.FromTyped(local, dyn.NilValue)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replace references to other resources with their IDs.
+	lv, err = dynvar.Resolve(lv, func(path dyn.Path) (dyn.Value, error) {
+		return lookupID(b, path)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rv, err := convert.FromTyped(remote, dyn.NilValue)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []Field
+	for _, change := range dyn.Diff(lv, rv) {
+		// Ignore fields that are only set in the workspace.
+		if !change.Before.IsValid() && len(change.Path) > 0 && change.Path[len(change.Path)-1].Key() != "" {
+			continue
+		}
+		fields = append(fields, Field{
+			Path:      change.Path.String(),
+			Bundle:    asAny(change.Before),
+			Workspace: asAny(change.After),
+		})
+	}
+	return fields, nil
+}
+
+func asAny(v dyn.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.AsAny()
+}
+
+// lookupID returns the ID of the deployed resource a reference points to. References are
+// either of the form `resources.jobs.foo.id`, or `databricks_job.foo.id` once they have been
+// rewritten for Terraform.
+func lookupID(b *bundle.Bundle, path dyn.Path) (dyn.Value, error) {
+	r := b.Config.Resources
+
+	var typ, key string
+	switch {
+	case len(path) == 4 && path[0] == dyn.Key("resources") && path[3] == dyn.Key("id"):
+		typ, key = path[1].Key(), path[2].Key()
+	case len(path) == 3 && path[2] == dyn.Key("id"):
+		key = path[1].Key()
+		if job, ok := r.Jobs[key]; ok && job != nil && job.TerraformResourceName() == path[0].Key() {
+			typ = "jobs"
+		}
+		if pipeline, ok := r.Pipelines[key]; ok && pipeline != nil && pipeline.TerraformResourceName() == path[0].Key() {
+			typ = "pipelines"
+		}
+	}
+
+	var id string
+	switch typ {
+	case "jobs":
+		if job, ok := r.Jobs[key]; ok && job != nil {
+			id = job.ID
+		}
+	case "pipelines":
+		if pipeline, ok := r.Pipelines[key]; ok && pipeline != nil {
+			id = pipeline.ID
+		}
+	}
+
+	if id == "" {
+		return dyn.InvalidValue, dynvar.ErrSkipResolution
+	}
+	return dyn.V(id), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
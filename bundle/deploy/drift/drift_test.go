@@ -0,0 +1,147 @@
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockBundle() *bundle.Bundle {
+	return &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"ingest": {
+						ID: "123",
+						JobSettings: &jobs.JobSettings{
+							Name:              "ingest",
+							MaxConcurrentRuns: 1,
+							Tasks: []jobs.Task{
+								{
+									TaskKey: "b",
+									PipelineTask: &jobs.PipelineTask{
+										PipelineId: "${databricks_pipeline.etl.id}",
+									},
+								},
+								{
+									TaskKey: "a",
+									NotebookTask: &jobs.NotebookTask{
+										NotebookPath: "/Workspace/files/notebook",
+									},
+								},
+							},
+						},
+					},
+					"undeployed": {
+						JobSettings: &jobs.JobSettings{Name: "undeployed"},
+					},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"etl": {
+						ID:           "pipeline-1",
+						PipelineSpec: &pipelines.PipelineSpec{Name: "etl"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDetectNoDrift(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle()
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	// Tasks are returned in a different order, the pipeline ID is resolved,
+	// and fields that are not configured are ignored.
+	m.GetMockJobsAPI().EXPECT().GetByJobId(mock.Anything, int64(123)).Return(&jobs.Job{
+		Settings: &jobs.JobSettings{
+			Name:              "ingest",
+			MaxConcurrentRuns: 1,
+			TimeoutSeconds:    3600,
+			Tasks: []jobs.Task{
+				{
+					TaskKey:      "a",
+					NotebookTask: &jobs.NotebookTask{NotebookPath: "/Workspace/files/notebook"},
+				},
+				{
+					TaskKey:      "b",
+					PipelineTask: &jobs.PipelineTask{PipelineId: "pipeline-1"},
+				},
+			},
+		},
+	}, nil)
+	m.GetMockPipelinesAPI().EXPECT().GetByPipelineId(mock.Anything, "pipeline-1").Return(&pipelines.GetPipelineResponse{
+		Spec: &pipelines.PipelineSpec{Id: "pipeline-1", Name: "etl"},
+	}, nil)
+
+	out, err := Detect(ctx, b)
+	require.NoError(t, err)
+	assert.Empty(t, out)
+}
+
+func TestDetectDrift(t *testing.T) {
+	ctx := context.Background()
+	b := mockBundle()
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockJobsAPI().EXPECT().GetByJobId(mock.Anything, int64(123)).Return(&jobs.Job{
+		Settings: &jobs.JobSettings{
+			Name:              "ingest",
+			MaxConcurrentRuns: 4,
+			Tasks: []jobs.Task{
+				{
+					TaskKey:      "a",
+					NotebookTask: &jobs.NotebookTask{NotebookPath: "/Workspace/files/notebook"},
+				},
+				{
+					TaskKey:      "b",
+					PipelineTask: &jobs.PipelineTask{PipelineId: "pipeline-1"},
+				},
+				{
+					TaskKey:      "c",
+					NotebookTask: &jobs.NotebookTask{NotebookPath: "/Users/someone/notebook"},
+				},
+			},
+		},
+	}, nil)
+	m.GetMockPipelinesAPI().EXPECT().GetByPipelineId(mock.Anything, "pipeline-1").
+		Return(nil, &apierr.APIError{StatusCode: 404, ErrorCode: "RESOURCE_DOES_NOT_EXIST"})
+
+	out, err := Detect(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, []Resource{
+		{
+			Type: "job",
+			Key:  "ingest",
+			ID:   "123",
+			Fields: []Field{
+				{Path: "max_concurrent_runs", Bundle: int64(1), Workspace: int64(4)},
+				{Path: "tasks[2]", Workspace: map[string]any{
+					"task_key":      "c",
+					"notebook_task": map[string]any{"notebook_path": "/Users/someone/notebook"},
+				}},
+			},
+		},
+		{
+			Type:    "pipeline",
+			Key:     "etl",
+			ID:      "pipeline-1",
+			Deleted: true,
+		},
+	}, out)
+}
@@ -17,6 +17,11 @@ func (m *delete) Name() string {
 }
 
 func (m *delete) Apply(ctx context.Context, b *bundle.Bundle) error {
+	// The destroy plan is computed by the deployment backend before files are deleted.
+	if b.Plan == nil {
+		return fmt.Errorf("unable to delete bundle files: resources were not destroyed")
+	}
+
 	// Do not delete files if terraform destroy was not consented
 	if !b.Plan.IsEmpty && !b.Plan.ConfirmApply {
 		return nil
@@ -25,9 +30,8 @@ func (m *delete) Apply(ctx context.Context, b *bundle.Bundle) error {
 	cmdio.LogString(ctx, "Starting deletion of remote bundle files")
 	cmdio.LogString(ctx, fmt.Sprintf("Bundle remote directory is %s", b.Config.Workspace.RootPath))
 
-	red := color.New(color.FgRed).SprintFunc()
 	if !b.AutoApprove {
-		proceed, err := cmdio.AskYesOrNo(ctx, fmt.Sprintf("\n%s and all files in it will be %s Proceed?", b.Config.Workspace.RootPath, red("deleted permanently!")))
+		proceed, err := cmdio.AskYesOrNo(ctx, fmt.Sprintf("\n%s and all files in it will be %s Proceed?", b.Config.Workspace.RootPath, cmdio.Sprintf(ctx, color.FgRed, "deleted permanently!")))
 		if err != nil {
 			return err
 		}
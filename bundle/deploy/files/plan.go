@@ -0,0 +1,48 @@
+package files
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/sync"
+)
+
+type plan struct{}
+
+func (m *plan) Name() string {
+	return "files.Plan"
+}
+
+func (m *plan) Apply(ctx context.Context, b *bundle.Bundle) error {
+	opts, err := getSyncOptions(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	put, del, err := sync.Preview(ctx, *opts)
+	if err != nil {
+		return err
+	}
+
+	if len(put) == 0 && len(del) == 0 {
+		cmdio.LogString(ctx, fmt.Sprintf("No changes to bundle files in %s", b.Config.Workspace.FilePath))
+		return nil
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("The following bundle files in %s will be changed:", b.Config.Workspace.FilePath))
+	for _, p := range put {
+		cmdio.LogString(ctx, "  upload file "+p)
+	}
+	for _, p := range del {
+		cmdio.LogString(ctx, "  delete file "+p)
+	}
+	return nil
+}
+
+// Plan returns a [bundle.Mutator] that prints the bundle files that
+// [Upload] would upload and delete, without making any changes.
+func Plan() bundle.Mutator {
+	return &plan{}
+}
@@ -0,0 +1,55 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+
+	"github.com/databricks/cli/libs/filer"
+)
+
+// ContentHashesFileName is the name of the file in the state directory of the
+// deployment that records the content hashes of the uploaded files.
+//
+// It is stored with the deployment state rather than only in the local sync
+// snapshot, such that unchanged files are not uploaded again by a deployment
+// from a fresh checkout (e.g. in CI).
+const ContentHashesFileName = "file_hashes.json"
+
+// readContentHashes returns the content hashes recorded by the previous deployment,
+// or nil if there are none.
+func readContentHashes(ctx context.Context, f filer.Filer) (map[string]string, error) {
+	r, err := f.Read(ctx, ContentHashesFileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes map[string]string
+	err = json.Unmarshal(raw, &hashes)
+	if err != nil {
+		return nil, fmt.Errorf("malformed %s: %w", ContentHashesFileName, err)
+	}
+	return hashes, nil
+}
+
+// writeContentHashes records the content hashes of the uploaded files.
+func writeContentHashes(ctx context.Context, f filer.Filer, hashes map[string]string) error {
+	raw, err := json.Marshal(hashes)
+	if err != nil {
+		return err
+	}
+	return f.Write(ctx, ContentHashesFileName, bytes.NewReader(raw), filer.CreateParentDirectories, filer.OverwriteIfExists)
+}
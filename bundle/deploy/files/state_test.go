@@ -0,0 +1,62 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	mockfiler "github.com/databricks/cli/internal/mocks/libs/filer"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadContentHashes(t *testing.T) {
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, ContentHashesFileName).
+		Return(io.NopCloser(bytes.NewReader([]byte(`{"a.py": "abc"}`))), nil)
+
+	hashes, err := readContentHashes(context.Background(), f)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a.py": "abc"}, hashes)
+}
+
+func TestReadContentHashesMissing(t *testing.T) {
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, ContentHashesFileName).
+		Return(nil, os.ErrNotExist)
+
+	hashes, err := readContentHashes(context.Background(), f)
+	require.NoError(t, err)
+	assert.Nil(t, hashes)
+}
+
+func TestReadContentHashesMalformed(t *testing.T) {
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, ContentHashesFileName).
+		Return(io.NopCloser(bytes.NewReader([]byte(`[]`))), nil)
+
+	_, err := readContentHashes(context.Background(), f)
+	assert.ErrorContains(t, err, "malformed file_hashes.json")
+}
+
+func TestWriteContentHashes(t *testing.T) {
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Write(mock.Anything, ContentHashesFileName, mock.Anything, filer.CreateParentDirectories, filer.OverwriteIfExists).
+		RunAndReturn(func(ctx context.Context, path string, r io.Reader, mode ...filer.WriteMode) error {
+			raw, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"a.py": "abc"}`, string(raw))
+			return nil
+		})
+
+	err := writeContentHashes(context.Background(), f, map[string]string{"a.py": "abc"})
+	require.NoError(t, err)
+}
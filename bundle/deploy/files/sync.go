@@ -8,7 +8,19 @@ import (
 	"github.com/databricks/cli/libs/sync"
 )
 
+// defaultMaxConcurrency is the number of files that are uploaded or deleted
+// concurrently if the bundle configuration doesn't specify it.
+const defaultMaxConcurrency = 10
+
 func getSync(ctx context.Context, b *bundle.Bundle) (*sync.Sync, error) {
+	opts, err := getSyncOptions(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	return sync.New(ctx, *opts)
+}
+
+func getSyncOptions(ctx context.Context, b *bundle.Bundle) (*sync.SyncOptions, error) {
 	cacheDir, err := b.CacheDir(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("cannot get bundle cache directory: %w", err)
@@ -19,17 +31,26 @@ func getSync(ctx context.Context, b *bundle.Bundle) (*sync.Sync, error) {
 		return nil, fmt.Errorf("cannot get list of sync includes: %w", err)
 	}
 
-	opts := sync.SyncOptions{
+	opts := &sync.SyncOptions{
 		LocalPath:  b.Config.Path,
 		RemotePath: b.Config.Workspace.FilePath,
 		Include:    includes,
 		Exclude:    b.Config.Sync.Exclude,
 
-		Full:        false,
-		CurrentUser: b.Config.Workspace.CurrentUser.User,
+		MaxConcurrency: defaultMaxConcurrency,
+
+		// Forcing an upload still uses the snapshot of the previous deployment,
+		// such that files that were removed locally are deleted remotely.
+		IgnoreContentHashes: b.Config.Bundle.ForceUpload,
+		CurrentUser:         b.Config.Workspace.CurrentUser.User,
 
 		SnapshotBasePath: cacheDir,
 		WorkspaceClient:  b.WorkspaceClient(),
 	}
-	return sync.New(ctx, opts)
+
+	if b.Config.Sync.MaxConcurrency > 0 {
+		opts.MaxConcurrency = b.Config.Sync.MaxConcurrency
+	}
+
+	return opts, nil
 }
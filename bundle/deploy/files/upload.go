@@ -7,9 +7,39 @@ import (
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/sync"
 )
 
-type upload struct{}
+// logUploadProgress logs the number of files uploaded until the event channel is closed.
+func logUploadProgress(ctx context.Context, events <-chan sync.Event) {
+	pending := make(map[string]bool)
+	var total, uploaded int64
+	for e := range events {
+		switch e := e.(type) {
+		case *sync.EventStart:
+			for _, p := range e.Put {
+				pending[p] = true
+			}
+			total = int64(len(pending))
+		case *sync.EventSyncProgress:
+			// Directories that are created are reported as puts as well.
+			if e.Action != sync.EventActionPut || e.Progress < 1.0 || !pending[e.Path] {
+				continue
+			}
+			pending[e.Path] = false
+			uploaded++
+			cmdio.Log(ctx, &cmdio.ProgressEvent{
+				Message: "Uploading bundle files",
+				Current: uploaded,
+				Total:   total,
+			})
+		}
+	}
+}
+
+type upload struct {
+	filerFunc
+}
 
 func (m *upload) Name() string {
 	return "files.Upload"
@@ -17,20 +47,52 @@ func (m *upload) Name() string {
 
 func (m *upload) Apply(ctx context.Context, b *bundle.Bundle) error {
 	cmdio.LogString(ctx, fmt.Sprintf("Uploading bundle files to %s...", b.Config.Workspace.FilePath))
-	sync, err := getSync(ctx, b)
+
+	f, err := m.filerFunc(b)
+	if err != nil {
+		return err
+	}
+
+	opts, err := getSyncOptions(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	// The hashes recorded by the previous deployment take precedence over the
+	// local snapshot, which may be missing or written by a different deployment.
+	opts.ContentHashes, err = readContentHashes(ctx, f)
+	if err != nil {
+		return fmt.Errorf("failed to read content hashes of deployed files: %w", err)
+	}
+
+	sync, err := sync.New(ctx, *opts)
 	if err != nil {
 		return err
 	}
 
+	done := make(chan struct{})
+	events := sync.Events()
+	go func() {
+		defer close(done)
+		logUploadProgress(ctx, events)
+	}()
+
 	err = sync.RunOnce(ctx)
+	sync.Close()
+	<-done
 	if err != nil {
 		return err
 	}
 
+	err = writeContentHashes(ctx, f, sync.ContentHashes())
+	if err != nil {
+		return fmt.Errorf("failed to record content hashes of deployed files: %w", err)
+	}
+
 	log.Infof(ctx, "Uploaded bundle files")
 	return nil
 }
 
 func Upload() bundle.Mutator {
-	return &upload{}
+	return &upload{stateFiler}
 }
@@ -0,0 +1,36 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/cli/libs/sync"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogUploadProgress(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := cmdio.NewContext(context.Background(), &cmdio.Logger{
+		Mode:   flags.ModeAppend,
+		Writer: &buf,
+	})
+
+	events := make(chan sync.Event, 10)
+	events <- &sync.EventStart{EventChanges: &sync.EventChanges{Put: []string{"a.py", "b.py"}}}
+	events <- &sync.EventSyncProgress{Action: sync.EventActionPut, Path: "dir", Progress: 1.0}
+	events <- &sync.EventSyncProgress{Action: sync.EventActionPut, Path: "a.py", Progress: 0.0}
+	events <- &sync.EventSyncProgress{Action: sync.EventActionPut, Path: "a.py", Progress: 1.0}
+	events <- &sync.EventSyncProgress{Action: sync.EventActionDelete, Path: "c.py", Progress: 1.0}
+	events <- &sync.EventSyncProgress{Action: sync.EventActionPut, Path: "b.py", Progress: 1.0}
+	close(events)
+
+	logUploadProgress(ctx, events)
+
+	out := buf.String()
+	assert.Contains(t, out, "Uploading bundle files [1/2]")
+	assert.Contains(t, out, "Uploading bundle files [2/2]")
+	assert.NotContains(t, out, "[3/2]")
+}
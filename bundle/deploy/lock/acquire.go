@@ -21,7 +21,7 @@ func (m *acquire) Name() string {
 	return "lock:acquire"
 }
 
-func (m *acquire) init(b *bundle.Bundle) error {
+func initLocker(b *bundle.Bundle) error {
 	user := b.Config.Workspace.CurrentUser.UserName
 	dir := b.Config.Workspace.StatePath
 	l, err := locker.CreateLocker(user, dir, b.WorkspaceClient())
@@ -40,7 +40,7 @@ func (m *acquire) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return nil
 	}
 
-	err := m.init(b)
+	err := initLocker(b)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,47 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+)
+
+type forceRelease struct{}
+
+// ForceRelease removes the deployment lock irrespective of who holds it.
+// It is used to recover from a deployment that did not release its lock.
+func ForceRelease() bundle.Mutator {
+	return &forceRelease{}
+}
+
+func (m *forceRelease) Name() string {
+	return "lock:force-release"
+}
+
+func (m *forceRelease) Apply(ctx context.Context, b *bundle.Bundle) error {
+	if !b.Config.Bundle.Deployment.Lock.IsEnabled() {
+		cmdio.LogString(ctx, "Locking is disabled for this bundle")
+		return nil
+	}
+
+	err := initLocker(b)
+	if err != nil {
+		return err
+	}
+
+	state, err := b.Locker.ForceUnlock(ctx)
+	if errors.Is(err, fs.ErrNotExist) {
+		cmdio.LogString(ctx, "No deployment lock to release")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Released deployment lock held by %s since %v", state.Holder(), state.AcquisitionTime))
+	return nil
+}
@@ -4,17 +4,31 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io/fs"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/metadata"
 	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
 )
 
 const MetadataFileName = "metadata.json"
 
-type upload struct{}
+// filerFunc is a function that returns a filer.Filer.
+type filerFunc func(b *bundle.Bundle) (filer.Filer, error)
+
+// metadataFiler returns a filer.Filer that can be used to read/write the metadata file.
+func metadataFiler(b *bundle.Bundle) (filer.Filer, error) {
+	return filer.NewWorkspaceFilesClient(b.WorkspaceClient(), b.Config.Workspace.StatePath)
+}
+
+type upload struct {
+	filerFunc
+}
 
 func Upload() bundle.Mutator {
-	return &upload{}
+	return &upload{metadataFiler}
 }
 
 func (m *upload) Name() string {
@@ -22,11 +36,23 @@ func (m *upload) Name() string {
 }
 
 func (m *upload) Apply(ctx context.Context, b *bundle.Bundle) error {
-	f, err := filer.NewWorkspaceFilesClient(b.WorkspaceClient(), b.Config.Workspace.StatePath)
+	f, err := m.filerFunc(b)
 	if err != nil {
 		return err
 	}
 
+	// A filtered deployment only includes a subset of the resources.
+	// Retain the metadata of the resources that were not deployed.
+	if b.Config.Bundle.IsFiltered() {
+		previous, err := readMetadata(ctx, f)
+		if err != nil {
+			return err
+		}
+		if previous != nil {
+			mergeMetadata(&b.Metadata, previous)
+		}
+	}
+
 	metadata, err := json.MarshalIndent(b.Metadata, "", "  ")
 	if err != nil {
 		return err
@@ -34,3 +60,37 @@ func (m *upload) Apply(ctx context.Context, b *bundle.Bundle) error {
 
 	return f.Write(ctx, MetadataFileName, bytes.NewReader(metadata), filer.CreateParentDirectories, filer.OverwriteIfExists)
 }
+
+// readMetadata reads the metadata of the previous deployment.
+// It returns nil if there was no previous deployment.
+func readMetadata(ctx context.Context, f filer.Filer) (*metadata.Metadata, error) {
+	r, err := f.Read(ctx, MetadataFileName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Infof(ctx, "Remote metadata file does not exist")
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer r.Close()
+
+	var previous metadata.Metadata
+	err = json.NewDecoder(r).Decode(&previous)
+	if err != nil {
+		return nil, err
+	}
+	return &previous, nil
+}
+
+// mergeMetadata adds the resources in the previous metadata that are
+// not part of the current metadata to the current metadata.
+func mergeMetadata(current *metadata.Metadata, previous *metadata.Metadata) {
+	for name, job := range previous.Config.Resources.Jobs {
+		if current.Config.Resources.Jobs == nil {
+			current.Config.Resources.Jobs = make(map[string]*metadata.Job)
+		}
+		if _, ok := current.Config.Resources.Jobs[name]; !ok {
+			current.Config.Resources.Jobs[name] = job
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/metadata"
+	mockfiler "github.com/databricks/cli/internal/mocks/libs/filer"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// identityFiler returns a filerFunc that returns the specified filer.
+func identityFiler(f filer.Filer) filerFunc {
+	return func(_ *bundle.Bundle) (filer.Filer, error) {
+		return f, nil
+	}
+}
+
+func expectMetadataWrite(t *testing.T, f *mockfiler.MockFiler) *metadata.Metadata {
+	var written metadata.Metadata
+	f.EXPECT().
+		Write(mock.Anything, MetadataFileName, mock.Anything, filer.CreateParentDirectories, filer.OverwriteIfExists).
+		Run(func(ctx context.Context, path string, reader io.Reader, mode ...filer.WriteMode) {
+			err := json.NewDecoder(reader).Decode(&written)
+			require.NoError(t, err)
+		}).
+		Return(nil).
+		Times(1)
+	return &written
+}
+
+func uploadTestBundle(only []string) *bundle.Bundle {
+	return &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Only: only,
+			},
+		},
+		Metadata: metadata.Metadata{
+			Version: metadata.Version,
+			Config: metadata.Config{
+				Resources: metadata.Resources{
+					Jobs: map[string]*metadata.Job{
+						"foo": {ID: "3", RelativePath: "databricks.yml"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestUploadMetadata(t *testing.T) {
+	f := mockfiler.NewMockFiler(t)
+	written := expectMetadataWrite(t, f)
+
+	b := uploadTestBundle(nil)
+	err := bundle.Apply(context.Background(), b, &upload{identityFiler(f)})
+	require.NoError(t, err)
+	assert.Equal(t, b.Metadata, *written)
+}
+
+func TestUploadMetadataWhenFilteredRetainsPreviousResources(t *testing.T) {
+	previous := metadata.Metadata{
+		Version: metadata.Version,
+		Config: metadata.Config{
+			Resources: metadata.Resources{
+				Jobs: map[string]*metadata.Job{
+					"foo": {ID: "1", RelativePath: "databricks.yml"},
+					"bar": {ID: "2", RelativePath: "resources/bar.yml"},
+				},
+			},
+		},
+	}
+	buf, err := json.Marshal(previous)
+	require.NoError(t, err)
+
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, MetadataFileName).
+		Return(io.NopCloser(bytes.NewReader(buf)), nil).
+		Times(1)
+	written := expectMetadataWrite(t, f)
+
+	b := uploadTestBundle([]string{"job:foo"})
+	err = bundle.Apply(context.Background(), b, &upload{identityFiler(f)})
+	require.NoError(t, err)
+
+	// The deployed job is updated; the job that was filtered out is retained.
+	assert.Equal(t, map[string]*metadata.Job{
+		"foo": {ID: "3", RelativePath: "databricks.yml"},
+		"bar": {ID: "2", RelativePath: "resources/bar.yml"},
+	}, written.Config.Resources.Jobs)
+}
+
+func TestUploadMetadataWhenFilteredWithoutPreviousMetadata(t *testing.T) {
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, MetadataFileName).
+		Return(nil, os.ErrNotExist).
+		Times(1)
+	written := expectMetadataWrite(t, f)
+
+	b := uploadTestBundle([]string{"job:foo"})
+	err := bundle.Apply(context.Background(), b, &upload{identityFiler(f)})
+	require.NoError(t, err)
+	assert.Equal(t, b.Metadata, *written)
+}
@@ -0,0 +1,17 @@
+// Package repo deploys the bundle's files by checking out the bundle's Git repository
+// in a workspace Git folder instead of uploading them.
+//
+// It is selected with `bundle.deployment.source: repo`. The Git folder is created at
+// `workspace.repo_path` and updated to the branch of the local checkout, so only changes
+// that have been pushed to the remote are deployed.
+package repo
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+)
+
+// IsEnabled returns true if the bundle is configured to be deployed from a Git folder.
+func IsEnabled(b *bundle.Bundle) bool {
+	return b.Config.Bundle.Deployment.Source == config.DeploymentSourceRepo
+}
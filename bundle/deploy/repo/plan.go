@@ -0,0 +1,37 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go/apierr"
+)
+
+type plan struct{}
+
+func (m *plan) Name() string {
+	return "repo.Plan"
+}
+
+func (m *plan) Apply(ctx context.Context, b *bundle.Bundle) error {
+	repoPath := b.Config.Workspace.RepoPath
+	_, err := b.WorkspaceClient().Workspace.GetStatusByPath(ctx, repoPath)
+	switch {
+	case apierr.IsMissing(err):
+		cmdio.LogString(ctx, fmt.Sprintf("Git folder %s will be created for %s at branch %s", repoPath, b.Config.Bundle.Git.OriginURL, checkoutBranch(b)))
+		return nil
+	case err != nil:
+		return err
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Git folder %s will be updated to branch %s", repoPath, checkoutBranch(b)))
+	return nil
+}
+
+// Plan returns a [bundle.Mutator] that prints the changes that
+// [Update] would make to the Git folder, without making any changes.
+func Plan() bundle.Mutator {
+	return &plan{}
+}
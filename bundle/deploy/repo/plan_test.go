@@ -0,0 +1,39 @@
+package repo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanMissingGitFolder(t *testing.T) {
+	b, m := mockBundle(t)
+	repoPath := b.Config.Workspace.RepoPath
+
+	m.GetMockWorkspaceAPI().EXPECT().GetStatusByPath(mock.Anything, repoPath).
+		Return(nil, &apierr.APIError{StatusCode: 404, ErrorCode: "RESOURCE_DOES_NOT_EXIST"})
+
+	var buf bytes.Buffer
+	err := bundle.Apply(testContext(&buf), b, Plan())
+	require.NoError(t, err)
+	assert.Equal(t, "Git folder "+repoPath+" will be created for git@github.com:databricks/cli.git at branch main\n", buf.String())
+}
+
+func TestPlanExistingGitFolder(t *testing.T) {
+	b, m := mockBundle(t)
+	repoPath := b.Config.Workspace.RepoPath
+
+	m.GetMockWorkspaceAPI().EXPECT().GetStatusByPath(mock.Anything, repoPath).
+		Return(&workspace.ObjectInfo{ObjectId: 42, ObjectType: workspace.ObjectTypeRepo}, nil)
+
+	var buf bytes.Buffer
+	err := bundle.Apply(testContext(&buf), b, Plan())
+	require.NoError(t, err)
+	assert.Equal(t, "Git folder "+repoPath+" will be updated to branch main\n", buf.String())
+}
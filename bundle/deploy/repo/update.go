@@ -0,0 +1,125 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/git"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+)
+
+// scpLikeURL matches SSH remotes in the scp-like syntax, for example `git@github.com:org/repo.git`.
+var scpLikeURL = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.+)$`)
+
+// remoteURL returns the HTTPS URL of the Git remote and its provider.
+// Git folders can only be created for HTTPS remotes, so SSH remotes are converted.
+func remoteURL(rawURL string) (string, string, error) {
+	out := rawURL
+	if !strings.Contains(rawURL, "://") {
+		if m := scpLikeURL.FindStringSubmatch(rawURL); m != nil {
+			out = fmt.Sprintf("https://%s/%s", m[1], m[2])
+		}
+	} else if u, err := url.Parse(rawURL); err == nil && u.Scheme == "ssh" {
+		out = (&url.URL{Scheme: "https", Host: u.Hostname(), Path: u.Path}).String()
+	}
+
+	provider := git.DetectProvider(out)
+	if provider == "" {
+		return "", "", fmt.Errorf("unable to detect the Git provider of %s; set bundle.git.origin_url to the HTTPS URL of a supported provider", rawURL)
+	}
+	return out, provider, nil
+}
+
+// checkoutBranch returns the branch to check out in the Git folder.
+func checkoutBranch(b *bundle.Bundle) string {
+	if b.Config.Bundle.Git.Branch != "" {
+		return b.Config.Bundle.Git.Branch
+	}
+	return b.Config.Bundle.Git.ActualBranch
+}
+
+type update struct{}
+
+func (m *update) Name() string {
+	return "repo.Update"
+}
+
+func (m *update) Apply(ctx context.Context, b *bundle.Bundle) error {
+	gitConfig := b.Config.Bundle.Git
+	if gitConfig.OriginURL == "" {
+		return fmt.Errorf("unable to deploy from a Git folder: the bundle has no Git remote; add a remote named origin or set bundle.git.origin_url")
+	}
+	branch := checkoutBranch(b)
+	if branch == "" {
+		return fmt.Errorf("unable to deploy from a Git folder: unable to determine the Git branch; set bundle.git.branch")
+	}
+	remote, provider, err := remoteURL(gitConfig.OriginURL)
+	if err != nil {
+		return err
+	}
+
+	repoPath := b.Config.Workspace.RepoPath
+	cmdio.LogString(ctx, fmt.Sprintf("Updating Git folder %s to branch %s...", repoPath, branch))
+
+	w := b.WorkspaceClient()
+	var repoID int64
+	info, err := w.Workspace.GetStatusByPath(ctx, repoPath)
+	switch {
+	case apierr.IsMissing(err):
+		err = w.Workspace.MkdirsByPath(ctx, path.Dir(repoPath))
+		if err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", path.Dir(repoPath), err)
+		}
+		repo, err := w.Repos.Create(ctx, workspace.CreateRepo{
+			Url:      remote,
+			Provider: provider,
+			Path:     repoPath,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create Git folder %s: %w", repoPath, err)
+		}
+		log.Infof(ctx, "Created Git folder %s for %s", repoPath, remote)
+		repoID = repo.Id
+	case err != nil:
+		return err
+	case info.ObjectType != workspace.ObjectTypeRepo:
+		return fmt.Errorf("%s already exists and is not a Git folder", repoPath)
+	default:
+		repoID = info.ObjectId
+	}
+
+	err = w.Repos.Update(ctx, workspace.UpdateRepo{
+		RepoId: repoID,
+		Branch: branch,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update Git folder %s to branch %s: %w", repoPath, branch, err)
+	}
+
+	// Git folders can only check out the head of a branch. Warn if it doesn't match the local
+	// checkout, for example because local commits have not been pushed yet.
+	repo, err := w.Repos.GetByRepoId(ctx, repoID)
+	if err != nil {
+		return err
+	}
+	if gitConfig.Commit != "" && repo.HeadCommitId != gitConfig.Commit {
+		cmdio.LogString(ctx, fmt.Sprintf("Warning: the Git folder is at commit %s of branch %s, but the local checkout is at commit %s. Push your changes to deploy them.", repo.HeadCommitId, branch, gitConfig.Commit))
+	}
+
+	log.Infof(ctx, "Updated Git folder %s", repoPath)
+	return nil
+}
+
+// Update creates or updates the workspace Git folder of the bundle
+// and checks out the branch of the local checkout.
+func Update() bundle.Mutator {
+	return &update{}
+}
@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteURL(t *testing.T) {
+	for in, expected := range map[string][2]string{
+		"https://github.com/databricks/cli.git":                  {"https://github.com/databricks/cli.git", "gitHub"},
+		"git@github.com:databricks/cli.git":                      {"https://github.com/databricks/cli.git", "gitHub"},
+		"ssh://git@gitlab.com/databricks/cli.git":                {"https://gitlab.com/databricks/cli.git", "gitLab"},
+		"https://user@dev.azure.com/org/project/_git/repository": {"https://user@dev.azure.com/org/project/_git/repository", "azureDevOpsServices"},
+	} {
+		url, provider, err := remoteURL(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, expected[0], url, in)
+		assert.Equal(t, expected[1], provider, in)
+	}
+
+	_, _, err := remoteURL("https://git.example.com/repo.git")
+	assert.ErrorContains(t, err, "unable to detect the Git provider of https://git.example.com/repo.git")
+}
+
+func mockBundle(t *testing.T) (*bundle.Bundle, *mocks.MockWorkspaceClient) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Deployment: config.Deployment{Source: config.DeploymentSourceRepo},
+				Git: config.Git{
+					OriginURL:    "git@github.com:databricks/cli.git",
+					Branch:       "main",
+					ActualBranch: "main",
+					Commit:       "abc",
+				},
+			},
+			Workspace: config.Workspace{
+				RepoPath: "/Users/jane@doe.com/.bundle/cli/default/repo",
+			},
+		},
+	}
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	return b, m
+}
+
+func testContext(buf *bytes.Buffer) context.Context {
+	return cmdio.NewContext(context.Background(), &cmdio.Logger{
+		Mode:   flags.ModeAppend,
+		Writer: buf,
+	})
+}
+
+func TestUpdateCreatesGitFolder(t *testing.T) {
+	b, m := mockBundle(t)
+	repoPath := b.Config.Workspace.RepoPath
+
+	m.GetMockWorkspaceAPI().EXPECT().GetStatusByPath(mock.Anything, repoPath).
+		Return(nil, &apierr.APIError{StatusCode: 404, ErrorCode: "RESOURCE_DOES_NOT_EXIST"})
+	m.GetMockWorkspaceAPI().EXPECT().MkdirsByPath(mock.Anything, "/Users/jane@doe.com/.bundle/cli/default").Return(nil)
+	m.GetMockReposAPI().EXPECT().Create(mock.Anything, workspace.CreateRepo{
+		Url:      "https://github.com/databricks/cli.git",
+		Provider: "gitHub",
+		Path:     repoPath,
+	}).Return(&workspace.RepoInfo{Id: 42}, nil)
+	m.GetMockReposAPI().EXPECT().Update(mock.Anything, workspace.UpdateRepo{RepoId: 42, Branch: "main"}).Return(nil)
+	m.GetMockReposAPI().EXPECT().GetByRepoId(mock.Anything, int64(42)).Return(&workspace.RepoInfo{Id: 42, HeadCommitId: "abc"}, nil)
+
+	var buf bytes.Buffer
+	err := bundle.Apply(testContext(&buf), b, Update())
+	require.NoError(t, err)
+	assert.NotContains(t, buf.String(), "Warning")
+}
+
+func TestUpdateExistingGitFolderWarnsAboutUnpushedCommits(t *testing.T) {
+	b, m := mockBundle(t)
+	repoPath := b.Config.Workspace.RepoPath
+
+	m.GetMockWorkspaceAPI().EXPECT().GetStatusByPath(mock.Anything, repoPath).
+		Return(&workspace.ObjectInfo{ObjectId: 42, ObjectType: workspace.ObjectTypeRepo}, nil)
+	m.GetMockReposAPI().EXPECT().Update(mock.Anything, workspace.UpdateRepo{RepoId: 42, Branch: "main"}).Return(nil)
+	m.GetMockReposAPI().EXPECT().GetByRepoId(mock.Anything, int64(42)).Return(&workspace.RepoInfo{Id: 42, HeadCommitId: "def"}, nil)
+
+	var buf bytes.Buffer
+	err := bundle.Apply(testContext(&buf), b, Update())
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "Warning: the Git folder is at commit def of branch main, but the local checkout is at commit abc.")
+}
+
+func TestUpdateFailsIfPathIsNotAGitFolder(t *testing.T) {
+	b, m := mockBundle(t)
+	repoPath := b.Config.Workspace.RepoPath
+
+	m.GetMockWorkspaceAPI().EXPECT().GetStatusByPath(mock.Anything, repoPath).
+		Return(&workspace.ObjectInfo{ObjectId: 42, ObjectType: workspace.ObjectTypeDirectory}, nil)
+
+	var buf bytes.Buffer
+	err := bundle.Apply(testContext(&buf), b, Update())
+	assert.EqualError(t, err, repoPath+" already exists and is not a Git folder")
+}
+
+func TestUpdateRequiresGitRemote(t *testing.T) {
+	b, _ := mockBundle(t)
+	b.Config.Bundle.Git.OriginURL = ""
+
+	var buf bytes.Buffer
+	err := bundle.Apply(testContext(&buf), b, Update())
+	assert.ErrorContains(t, err, "the bundle has no Git remote")
+}
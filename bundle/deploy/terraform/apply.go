@@ -2,7 +2,11 @@ package terraform
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/libs/cmdio"
@@ -29,7 +33,25 @@ func (w *apply) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return fmt.Errorf("terraform init: %w", err)
 	}
 
-	err = tf.Apply(ctx)
+	var opts []tfexec.ApplyOption
+	if b.Config.Bundle.IsFiltered() {
+		// Resources that are filtered out are absent from the configuration.
+		// Target the remaining resources such that the others are left untouched
+		// instead of being destroyed.
+		addresses, err := resourceAddresses(ctx, b)
+		if err != nil {
+			return err
+		}
+		if len(addresses) == 0 {
+			log.Infof(ctx, "No resources selected for deployment")
+			return nil
+		}
+		for _, address := range addresses {
+			opts = append(opts, tfexec.Target(address))
+		}
+	}
+
+	err = tf.Apply(ctx, opts...)
 	if err != nil {
 		return fmt.Errorf("terraform apply: %w", err)
 	}
@@ -38,6 +60,36 @@ func (w *apply) Apply(ctx context.Context, b *bundle.Bundle) error {
 	return nil
 }
 
+// resourceAddresses returns the addresses of all resources in the Terraform configuration.
+func resourceAddresses(ctx context.Context, b *bundle.Bundle) ([]string, error) {
+	dir, err := Dir(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, TerraformConfigFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var config struct {
+		Resource map[string]map[string]json.RawMessage `json:"resource"`
+	}
+	err = json.Unmarshal(raw, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	for typ, resources := range config.Resource {
+		for name := range resources {
+			addresses = append(addresses, typ+"."+name)
+		}
+	}
+	sort.Strings(addresses)
+	return addresses, nil
+}
+
 // Apply returns a [bundle.Mutator] that runs the equivalent of `terraform apply`
 // from the bundle's ephemeral working directory for Terraform.
 func Apply() bundle.Mutator {
@@ -0,0 +1,49 @@
+package terraform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceAddresses(t *testing.T) {
+	ctx := context.Background()
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Path: t.TempDir(),
+			Bundle: config.Bundle{
+				Target: "default",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"ingest": {
+						JobSettings: &jobs.JobSettings{Name: "ingest"},
+						Permissions: []resources.Permission{
+							{Level: "CAN_VIEW", UserName: "someone@example.com"},
+						},
+					},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"etl": {},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(ctx, b, Write())
+	require.NoError(t, err)
+
+	addresses, err := resourceAddresses(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"databricks_job.ingest",
+		"databricks_permissions.job_ingest",
+		"databricks_pipeline.etl",
+	}, addresses)
+}
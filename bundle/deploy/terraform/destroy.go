@@ -26,22 +26,32 @@ func (c *PlanResourceChange) String() string {
 	default:
 		result.WriteString(c.Action + " ")
 	}
-	switch c.ResourceType {
+	result.WriteString(resourceTypeName(c.ResourceType) + " ")
+	result.WriteString(c.ResourceName)
+	return result.String()
+}
+
+// resourceTypeName returns the name of a Terraform resource type as it is
+// known in bundle configuration, if it has one.
+func resourceTypeName(typ string) string {
+	switch typ {
 	case "databricks_job":
-		result.WriteString("job ")
+		return "job"
 	case "databricks_pipeline":
-		result.WriteString("pipeline ")
+		return "pipeline"
 	default:
-		result.WriteString(c.ResourceType + " ")
+		return typ
 	}
-	result.WriteString(c.ResourceName)
-	return result.String()
 }
 
 func (c *PlanResourceChange) IsInplaceSupported() bool {
 	return false
 }
 
+func (c *PlanResourceChange) Type() string {
+	return "resource_change"
+}
+
 func logDestroyPlan(ctx context.Context, changes []*tfjson.ResourceChange) error {
 	cmdio.LogString(ctx, "The following resources will be removed:")
 	for _, c := range changes {
@@ -56,6 +66,29 @@ func logDestroyPlan(ctx context.Context, changes []*tfjson.ResourceChange) error
 	return nil
 }
 
+// orphanedResources returns the resources that Terraform found to be deleted
+// outside of the bundle when it refreshed the state.
+func orphanedResources(drift []*tfjson.ResourceChange) []*tfjson.ResourceChange {
+	var out []*tfjson.ResourceChange
+	for _, c := range drift {
+		if c.Change != nil && c.Change.Actions.Delete() {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func logOrphanedResources(ctx context.Context, drift []*tfjson.ResourceChange) {
+	orphaned := orphanedResources(drift)
+	if len(orphaned) == 0 {
+		return
+	}
+	cmdio.LogString(ctx, "The following resources were already deleted outside of the bundle:")
+	for _, c := range orphaned {
+		cmdio.LogString(ctx, fmt.Sprintf("  %s %s", resourceTypeName(c.Type), c.Name))
+	}
+}
+
 type destroy struct{}
 
 func (w *destroy) Name() string {
@@ -63,23 +96,30 @@ func (w *destroy) Name() string {
 }
 
 func (w *destroy) Apply(ctx context.Context, b *bundle.Bundle) error {
-	// return early if plan is empty
-	if b.Plan.IsEmpty {
-		cmdio.LogString(ctx, "No resources to destroy in plan. Skipping destroy!")
-		return nil
-	}
-
 	tf := b.Terraform
 	if tf == nil {
 		return fmt.Errorf("terraform not initialized")
 	}
 
+	if b.Plan.Path == "" {
+		return fmt.Errorf("no plan found")
+	}
+
 	// read plan file
 	plan, err := tf.ShowPlanFile(ctx, b.Plan.Path)
 	if err != nil {
 		return err
 	}
 
+	// print the resources that were deleted outside of the bundle
+	logOrphanedResources(ctx, plan.ResourceDrift)
+
+	// return early if plan is empty
+	if b.Plan.IsEmpty {
+		cmdio.LogString(ctx, "No resources to destroy in plan. Skipping destroy!")
+		return nil
+	}
+
 	// print the resources that will be destroyed
 	err = logDestroyPlan(ctx, plan.ResourceChanges)
 	if err != nil {
@@ -88,8 +128,7 @@ func (w *destroy) Apply(ctx context.Context, b *bundle.Bundle) error {
 
 	// Ask for confirmation, if needed
 	if !b.Plan.ConfirmApply {
-		red := color.New(color.FgRed).SprintFunc()
-		b.Plan.ConfirmApply, err = cmdio.AskYesOrNo(ctx, fmt.Sprintf("\nThis will permanently %s resources! Proceed?", red("destroy")))
+		b.Plan.ConfirmApply, err = cmdio.AskYesOrNo(ctx, fmt.Sprintf("\nThis will permanently %s resources! Proceed?", cmdio.Sprintf(ctx, color.FgRed, "destroy")))
 		if err != nil {
 			return err
 		}
@@ -100,10 +139,6 @@ func (w *destroy) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return nil
 	}
 
-	if b.Plan.Path == "" {
-		return fmt.Errorf("no plan found")
-	}
-
 	cmdio.LogString(ctx, "Starting to destroy resources")
 
 	// Apply terraform according to the computed destroy plan
@@ -0,0 +1,28 @@
+package terraform
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrphanedResources(t *testing.T) {
+	drift := []*tfjson.ResourceChange{
+		{
+			Type:   "databricks_job",
+			Name:   "deleted",
+			Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+		},
+		{
+			Type:   "databricks_pipeline",
+			Name:   "modified",
+			Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+		},
+	}
+
+	orphaned := orphanedResources(drift)
+	assert.Len(t, orphaned, 1)
+	assert.Equal(t, "deleted", orphaned[0].Name)
+	assert.Empty(t, orphanedResources(nil))
+}
@@ -90,3 +90,34 @@ func TestInterpolateUnknownResourceType(t *testing.T) {
 	err := bundle.Apply(context.Background(), b, Interpolate())
 	assert.Contains(t, err.Error(), `reference does not exist: ${resources.unknown.other_unknown.id}`)
 }
+
+func TestInterpolatePipelineTaskReference(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"my_job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									TaskKey: "refresh",
+									PipelineTask: &jobs.PipelineTask{
+										PipelineId: "${resources.pipelines.etl.id}",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, Interpolate())
+	require.NoError(t, err)
+
+	// Terraform resolves the reference once the pipeline has been created,
+	// and orders the creation of the job after the pipeline.
+	task := b.Config.Resources.Jobs["my_job"].Tasks[0]
+	assert.Equal(t, "${databricks_pipeline.etl.id}", task.PipelineTask.PipelineId)
+}
@@ -0,0 +1,162 @@
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/dyn"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+type showPlan struct{}
+
+func (m *showPlan) Name() string {
+	return "terraform.ShowPlan"
+}
+
+func (m *showPlan) Apply(ctx context.Context, b *bundle.Bundle) error {
+	tf := b.Terraform
+	if tf == nil {
+		return fmt.Errorf("terraform not initialized")
+	}
+
+	if b.Plan == nil || b.Plan.Path == "" {
+		return fmt.Errorf("no plan found")
+	}
+
+	if b.Plan.IsEmpty {
+		cmdio.LogString(ctx, "No changes to resources")
+		return nil
+	}
+
+	plan, err := tf.ShowPlanFile(ctx, b.Plan.Path)
+	if err != nil {
+		return err
+	}
+
+	cmdio.LogString(ctx, "The following resources will be changed:")
+	for _, line := range describeResourceChanges(plan.ResourceChanges) {
+		cmdio.LogString(ctx, line)
+	}
+	return nil
+}
+
+// ShowPlan returns a [bundle.Mutator] that prints the resources that are created,
+// updated, recreated or deleted by the plan computed by [Plan]. For updated and
+// recreated resources, it includes the fields that change.
+func ShowPlan() bundle.Mutator {
+	return &showPlan{}
+}
+
+func resourceChangeAction(actions tfjson.Actions) string {
+	switch {
+	case actions.Replace():
+		return "recreate"
+	case actions.Create():
+		return "create"
+	case actions.Update():
+		return "update"
+	case actions.Delete():
+		return "delete"
+	default:
+		return ""
+	}
+}
+
+// describeResourceChanges returns one line per changed resource, each followed
+// by the field-level changes for updated and recreated resources.
+func describeResourceChanges(changes []*tfjson.ResourceChange) []string {
+	var lines []string
+	for _, c := range changes {
+		if c.Change == nil {
+			continue
+		}
+
+		action := resourceChangeAction(c.Change.Actions)
+		if action == "" {
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("  %s %s %s", action, resourceTypeName(c.Type), c.Name))
+		if action == "update" || action == "recreate" {
+			lines = append(lines, describeFieldChanges(c.Change)...)
+		}
+	}
+	return lines
+}
+
+func describeFieldChanges(c *tfjson.Change) []string {
+	unknown := fromJSON(c.AfterUnknown)
+
+	var lines []string
+	for _, change := range dyn.Diff(fromJSON(c.Before), fromJSON(c.After)) {
+		switch {
+		case isUnknown(unknown, change.Path):
+			lines = append(lines, fmt.Sprintf("      ~ %s: %s -> (known after apply)", change.Path, formatValue(change.Before)))
+		case !change.Before.IsValid():
+			lines = append(lines, fmt.Sprintf("      + %s: %s", change.Path, formatValue(change.After)))
+		case !change.After.IsValid():
+			lines = append(lines, fmt.Sprintf("      - %s: %s", change.Path, formatValue(change.Before)))
+		default:
+			lines = append(lines, fmt.Sprintf("      ~ %s: %s -> %s", change.Path, formatValue(change.Before), formatValue(change.After)))
+		}
+	}
+	return lines
+}
+
+// isUnknown returns true if the value at the specified path (or one of its parents)
+// is only known after the plan is applied.
+func isUnknown(unknown dyn.Value, p dyn.Path) bool {
+	for i := 0; i <= len(p); i++ {
+		v, err := dyn.GetByPath(unknown, p[:i])
+		if err != nil {
+			return false
+		}
+		if b, ok := v.AsBool(); ok && b {
+			return true
+		}
+	}
+	return false
+}
+
+// fromJSON converts a value decoded from the JSON representation of a plan.
+func fromJSON(v any) dyn.Value {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]dyn.Value, len(v))
+		for k, e := range v {
+			out[k] = fromJSON(e)
+		}
+		return dyn.V(out)
+	case []any:
+		out := make([]dyn.Value, len(v))
+		for i, e := range v {
+			out[i] = fromJSON(e)
+		}
+		return dyn.V(out)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return dyn.V(i)
+		}
+		f, _ := v.Float64()
+		return dyn.V(f)
+	case string, bool, float64, nil:
+		return dyn.V(v)
+	default:
+		return dyn.V(fmt.Sprint(v))
+	}
+}
+
+func formatValue(v dyn.Value) string {
+	if !v.IsValid() {
+		return "null"
+	}
+	buf, err := json.Marshal(v.AsAny())
+	if err != nil {
+		return fmt.Sprint(v.AsAny())
+	}
+	return string(buf)
+}
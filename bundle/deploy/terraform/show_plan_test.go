@@ -0,0 +1,84 @@
+package terraform
+
+import (
+	"encoding/json"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeResourceChanges(t *testing.T) {
+	changes := []*tfjson.ResourceChange{
+		{
+			Type: "databricks_job",
+			Name: "new",
+			Change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionCreate},
+				After:   map[string]any{"name": "new"},
+			},
+		},
+		{
+			Type: "databricks_job",
+			Name: "changed",
+			Change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionUpdate},
+				Before: map[string]any{
+					"name":                "old name",
+					"max_concurrent_runs": json.Number("1"),
+					"url":                 "https://example.com/jobs/1",
+					"task": []any{
+						map[string]any{"task_key": "a"},
+					},
+				},
+				After: map[string]any{
+					"name":                "new name",
+					"max_concurrent_runs": json.Number("2"),
+					"task": []any{
+						map[string]any{"task_key": "a"},
+						map[string]any{"task_key": "b"},
+					},
+					"tags": map[string]any{"team": "data"},
+				},
+				AfterUnknown: map[string]any{"url": true},
+			},
+		},
+		{
+			Type: "databricks_pipeline",
+			Name: "unchanged",
+			Change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionNoop},
+			},
+		},
+		{
+			Type: "databricks_pipeline",
+			Name: "replaced",
+			Change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+				Before:  map[string]any{"catalog": "main"},
+				After:   map[string]any{"catalog": "dev"},
+			},
+		},
+		{
+			Type: "databricks_permissions",
+			Name: "job_removed",
+			Change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionDelete},
+				Before:  map[string]any{"job_id": "1"},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"  create job new",
+		"  update job changed",
+		`      ~ max_concurrent_runs: 1 -> 2`,
+		`      ~ name: "old name" -> "new name"`,
+		`      + tags: {"team":"data"}`,
+		`      + task[1]: {"task_key":"b"}`,
+		`      ~ url: "https://example.com/jobs/1" -> (known after apply)`,
+		"  recreate pipeline replaced",
+		`      ~ catalog: "main" -> "dev"`,
+		"  delete databricks_permissions job_removed",
+	}, describeResourceChanges(changes))
+}
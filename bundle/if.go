@@ -0,0 +1,34 @@
+package bundle
+
+import "context"
+
+type ifMutator struct {
+	condition func(*Bundle) bool
+	onTrue    Mutator
+	onFalse   Mutator
+}
+
+func (m *ifMutator) Name() string {
+	return "if"
+}
+
+func (m *ifMutator) Apply(ctx context.Context, b *Bundle) error {
+	next := m.onFalse
+	if m.condition(b) {
+		next = m.onTrue
+	}
+	if next == nil {
+		return nil
+	}
+	return Apply(ctx, b, next)
+}
+
+// If applies onTrue if the condition holds for the bundle and onFalse otherwise.
+// The condition is evaluated when the mutator is applied. Either mutator may be nil.
+func If(condition func(*Bundle) bool, onTrue Mutator, onFalse Mutator) Mutator {
+	return &ifMutator{
+		condition: condition,
+		onTrue:    onTrue,
+		onFalse:   onFalse,
+	}
+}
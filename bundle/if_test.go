@@ -0,0 +1,42 @@
+package bundle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIfMutator(t *testing.T) {
+	m1 := &testMutator{}
+	m2 := &testMutator{}
+
+	b := &Bundle{}
+	err := Apply(context.Background(), b, If(func(*Bundle) bool { return true }, m1, m2))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, m1.applyCalled)
+	assert.Equal(t, 0, m2.applyCalled)
+
+	err = Apply(context.Background(), b, If(func(*Bundle) bool { return false }, m1, m2))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, m1.applyCalled)
+	assert.Equal(t, 1, m2.applyCalled)
+}
+
+func TestIfMutatorWithoutElse(t *testing.T) {
+	m1 := &testMutator{}
+
+	b := &Bundle{}
+	err := Apply(context.Background(), b, If(func(*Bundle) bool { return false }, m1, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, m1.applyCalled)
+}
+
+func TestIfMutatorWithoutThen(t *testing.T) {
+	m2 := &testMutator{}
+
+	b := &Bundle{}
+	err := Apply(context.Background(), b, If(func(*Bundle) bool { return true }, nil, m2))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, m2.applyCalled)
+}
@@ -16,7 +16,12 @@ type Mutator interface {
 	Apply(context.Context, *Bundle) error
 }
 
+// Apply applies the mutator to the bundle.
+//
+// If debug logging is enabled, it logs the duration of the mutator and the
+// changes it made to the configuration.
 func Apply(ctx context.Context, b *Bundle, m Mutator) error {
+	parent := ctx
 	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("mutator", m.Name()))
 
 	log.Debugf(ctx, "Apply")
@@ -34,12 +39,14 @@ func Apply(ctx context.Context, b *Bundle, m Mutator) error {
 		}
 	}()
 
+	ctx, t := startTrace(ctx, b)
 	err = m.Apply(ctx, b)
 	if err != nil {
 		log.Errorf(ctx, "Error: %s", err)
 		return err
 	}
 
+	t.finish(ctx, parent, b)
 	return nil
 }
 
@@ -1,10 +1,15 @@
 package bundle
 
 import (
+	"bytes"
 	"context"
+	"log/slog"
+	"strings"
 	"testing"
 
+	"github.com/databricks/cli/libs/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testMutator struct {
@@ -42,3 +47,42 @@ func TestMutator(t *testing.T) {
 	assert.Equal(t, 1, nested[0].applyCalled)
 	assert.Equal(t, 1, nested[1].applyCalled)
 }
+
+func TestMutatorLogsChangesWhenDebugging(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: log.LevelDebug}))
+	ctx := log.NewContext(context.Background(), logger)
+
+	setName := func(name string) Mutator {
+		return funcMutator{func(ctx context.Context, b *Bundle) error {
+			b.Config.Bundle.Name = name
+			return nil
+		}}
+	}
+
+	b := &Bundle{}
+	err := Apply(ctx, b, Seq(setName("foo"), setName("bar")))
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, `Apply done in`)
+	assert.Contains(t, out, `Changed bundle.name: <unset> -> \"foo\"`)
+	assert.Contains(t, out, `Changed bundle.name: \"foo\" -> \"bar\"`)
+
+	// The changes of the sequence were already logged by the nested mutators.
+	assert.Equal(t, 2, strings.Count(out, "Changed bundle.name"))
+}
+
+func TestMutatorDoesNotLogChangesByDefault(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: log.LevelInfo}))
+	ctx := log.NewContext(context.Background(), logger)
+
+	b := &Bundle{}
+	err := ApplyFunc(ctx, b, func(ctx context.Context, b *Bundle) error {
+		b.Config.Bundle.Name = "foo"
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
@@ -0,0 +1,81 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/log"
+)
+
+type traceKeyType int
+
+const traceKey traceKeyType = iota
+
+// trace records the execution of a single mutator when debug logging is enabled.
+type trace struct {
+	start  time.Time
+	before dyn.Value
+
+	// Set if a nested mutator reported changes. The changes of a mutator
+	// are only logged if they weren't already logged by a nested mutator.
+	nestedChanges bool
+}
+
+func traceEnabled(ctx context.Context) bool {
+	return log.GetLogger(ctx).Enabled(ctx, log.LevelDebug)
+}
+
+// startTrace captures the configuration before a mutator is applied.
+func startTrace(ctx context.Context, b *Bundle) (context.Context, *trace) {
+	if !traceEnabled(ctx) {
+		return ctx, nil
+	}
+
+	before, err := b.Config.Snapshot()
+	if err != nil {
+		log.Debugf(ctx, "unable to capture configuration: %s", err)
+		return ctx, nil
+	}
+
+	t := &trace{start: time.Now(), before: before}
+	return context.WithValue(ctx, traceKey, t), t
+}
+
+// finish logs the duration of the mutator and the changes it made to the configuration.
+func (t *trace) finish(ctx context.Context, parent context.Context, b *Bundle) {
+	if t == nil {
+		return
+	}
+
+	duration := time.Since(t.start)
+	after, err := b.Config.Snapshot()
+	if err != nil {
+		log.Debugf(ctx, "Apply done in %s", duration)
+		return
+	}
+
+	changes := dyn.Diff(t.before, after)
+	log.Debugf(ctx, "Apply done in %s (%d changes)", duration, len(changes))
+	if len(changes) == 0 {
+		return
+	}
+
+	if !t.nestedChanges {
+		for _, c := range changes {
+			log.Debugf(ctx, "Changed %s: %s -> %s", c.Path, formatTraceValue(c.Before), formatTraceValue(c.After))
+		}
+	}
+
+	if pt, ok := parent.Value(traceKey).(*trace); ok {
+		pt.nestedChanges = true
+	}
+}
+
+func formatTraceValue(v dyn.Value) string {
+	if !v.IsValid() {
+		return "<unset>"
+	}
+	return fmt.Sprintf("%#v", v.AsAny())
+}
@@ -6,9 +6,11 @@ import (
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/bundle/deploy"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/files"
 	"github.com/databricks/cli/bundle/deploy/lock"
 	"github.com/databricks/cli/bundle/deploy/metadata"
+	"github.com/databricks/cli/bundle/deploy/repo"
 	"github.com/databricks/cli/bundle/deploy/terraform"
 	"github.com/databricks/cli/bundle/libraries"
 	"github.com/databricks/cli/bundle/permissions"
@@ -23,24 +25,51 @@ func Deploy() bundle.Mutator {
 		lock.Acquire(),
 		bundle.Defer(
 			bundle.Seq(
-				terraform.StatePull(),
-				deploy.CheckRunningResource(),
+				bundle.If(
+					direct.IsEnabled,
+					direct.StatePull(),
+					bundle.Seq(
+						terraform.StatePull(),
+						deploy.CheckRunningResource(),
+					),
+				),
 				mutator.ValidateGitDetails(),
+				mutator.ValidateResourceDependencies(),
 				libraries.MatchWithArtifacts(),
 				artifacts.CleanUp(),
 				artifacts.UploadAll(),
 				python.TransformWheelTask(),
-				files.Upload(),
+				bundle.If(
+					repo.IsEnabled,
+					repo.Update(),
+					files.Upload(),
+				),
 				permissions.ApplyWorkspaceRootPermissions(),
-				terraform.Interpolate(),
-				terraform.Write(),
-				bundle.Defer(
-					terraform.Apply(),
+				bundle.If(
+					direct.IsEnabled,
+					bundle.Seq(
+						direct.StateCheck(),
+						bundle.Defer(
+							direct.Deploy(),
+							bundle.Seq(
+								direct.StatePush(),
+								metadata.Compute(),
+								metadata.Upload(),
+							),
+						),
+					),
 					bundle.Seq(
-						terraform.StatePush(),
-						terraform.Load(),
-						metadata.Compute(),
-						metadata.Upload(),
+						terraform.Interpolate(),
+						terraform.Write(),
+						bundle.Defer(
+							terraform.Apply(),
+							bundle.Seq(
+								terraform.StatePush(),
+								terraform.Load(),
+								metadata.Compute(),
+								metadata.Upload(),
+							),
+						),
 					),
 				),
 			),
@@ -2,6 +2,7 @@ package phases
 
 import (
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/files"
 	"github.com/databricks/cli/bundle/deploy/lock"
 	"github.com/databricks/cli/bundle/deploy/terraform"
@@ -14,12 +15,22 @@ func Destroy() bundle.Mutator {
 		lock.Acquire(),
 		bundle.Defer(
 			bundle.Seq(
-				terraform.StatePull(),
-				terraform.Interpolate(),
-				terraform.Write(),
-				terraform.Plan(terraform.PlanGoal("destroy")),
-				terraform.Destroy(),
-				terraform.StatePush(),
+				bundle.If(
+					direct.IsEnabled,
+					bundle.Seq(
+						direct.StatePull(),
+						direct.Destroy(),
+						direct.StatePush(),
+					),
+					bundle.Seq(
+						terraform.StatePull(),
+						terraform.Interpolate(),
+						terraform.Write(),
+						terraform.Plan(terraform.PlanGoal("destroy")),
+						terraform.Destroy(),
+						terraform.StatePush(),
+					),
+				),
 				files.Delete(),
 			),
 			lock.Release(lock.GoalDestroy),
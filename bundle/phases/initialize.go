@@ -4,6 +4,8 @@ import (
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/metadata"
 	"github.com/databricks/cli/bundle/deploy/terraform"
 	"github.com/databricks/cli/bundle/permissions"
@@ -18,10 +20,18 @@ func Initialize() bundle.Mutator {
 	return newPhase(
 		"initialize",
 		[]bundle.Mutator{
+			mutator.ResolveDefinitions(),
 			mutator.RewriteSyncPaths(),
+			mutator.FilterResourcesByTarget(),
 			mutator.MergeJobClusters(),
 			mutator.MergeJobTasks(),
+			mutator.ValidateJobTaskLimit(),
+			mutator.ValidateDeploymentBackend(),
+			mutator.ValidateDeploymentSource(),
 			mutator.MergePipelineClusters(),
+			// Validate the configuration before connecting to the workspace.
+			mutator.ApplyWorkspaceDefaults(),
+			validate.Mutator(),
 			mutator.InitializeWorkspaceClient(),
 			mutator.PopulateCurrentUser(),
 			mutator.DefineDefaultWorkspaceRoot(),
@@ -36,6 +46,8 @@ func Initialize() bundle.Mutator {
 			),
 			mutator.SetRunAs(),
 			mutator.OverrideCompute(),
+			mutator.ApplyWorkspaceTags(),
+			mutator.ApplyWorkspaceDefaults(),
 			mutator.ProcessTargetMode(),
 			mutator.ExpandPipelineGlobPaths(),
 			mutator.TranslatePaths(),
@@ -43,7 +55,7 @@ func Initialize() bundle.Mutator {
 			permissions.ApplyBundlePermissions(),
 			permissions.FilterCurrentUser(),
 			metadata.AnnotateJobs(),
-			terraform.Initialize(),
+			bundle.If(direct.IsEnabled, nil, terraform.Initialize()),
 			scripts.Execute(config.ScriptPostInit),
 		},
 	)
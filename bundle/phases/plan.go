@@ -0,0 +1,47 @@
+package phases
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/deploy/direct"
+	"github.com/databricks/cli/bundle/deploy/files"
+	"github.com/databricks/cli/bundle/deploy/repo"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/libraries"
+	"github.com/databricks/cli/bundle/python"
+)
+
+// The plan phase prints the changes to resources and files that the
+// deploy phase would make, without making any changes in the workspace.
+func Plan() bundle.Mutator {
+	planMutator := bundle.Seq(
+		mutator.ValidateGitDetails(),
+		mutator.ValidateResourceDependencies(),
+		libraries.MatchWithArtifacts(),
+		python.TransformWheelTask(),
+		bundle.If(
+			direct.IsEnabled,
+			bundle.Seq(
+				direct.StatePull(),
+				direct.Plan(),
+			),
+			bundle.Seq(
+				terraform.StatePull(),
+				terraform.Interpolate(),
+				terraform.Write(),
+				terraform.Plan(terraform.PlanDeploy),
+				terraform.ShowPlan(),
+			),
+		),
+		bundle.If(
+			repo.IsEnabled,
+			repo.Plan(),
+			files.Plan(),
+		),
+	)
+
+	return newPhase(
+		"plan",
+		[]bundle.Mutator{planMutator},
+	)
+}
@@ -31,3 +31,7 @@ func (event *JobProgressEvent) String() string {
 func (event *JobProgressEvent) IsInplaceSupported() bool {
 	return true
 }
+
+func (event *JobProgressEvent) Type() string {
+	return "job_progress"
+}
@@ -31,15 +31,17 @@ func (event *TaskErrorEvent) IsInplaceSupported() bool {
 	return false
 }
 
+func (event *TaskErrorEvent) Type() string {
+	return "task_error"
+}
+
 type JobRunUrlEvent struct {
-	Type string `json:"type"`
-	Url  string `json:"url"`
+	Url string `json:"url"`
 }
 
 func NewJobRunUrlEvent(url string) *JobRunUrlEvent {
 	return &JobRunUrlEvent{
-		Type: "job_run_url",
-		Url:  url,
+		Url: url,
 	}
 }
 
@@ -50,3 +52,7 @@ func (event *JobRunUrlEvent) String() string {
 func (event *JobRunUrlEvent) IsInplaceSupported() bool {
 	return false
 }
+
+func (event *JobRunUrlEvent) Type() string {
+	return "job_run_url"
+}
@@ -43,6 +43,10 @@ func (event *ProgressEvent) IsInplaceSupported() bool {
 	return false
 }
 
+func (event *ProgressEvent) Type() string {
+	return "pipeline_progress"
+}
+
 // TODO: Add inplace logging to pipelines. https://github.com/databricks/cli/issues/280
 type UpdateTracker struct {
 	UpdateId             string
@@ -3,7 +3,6 @@ package progress
 import "fmt"
 
 type PipelineUpdateUrlEvent struct {
-	Type       string `json:"type"`
 	UpdateId   string `json:"update_id"`
 	PipelineId string `json:"pipeline_id"`
 	Url        string `json:"url"`
@@ -11,7 +10,6 @@ type PipelineUpdateUrlEvent struct {
 
 func NewPipelineUpdateUrlEvent(host, updateId, pipelineId string) *PipelineUpdateUrlEvent {
 	return &PipelineUpdateUrlEvent{
-		Type:       "pipeline_update_url",
 		UpdateId:   updateId,
 		PipelineId: pipelineId,
 		Url:        fmt.Sprintf("%s/#joblist/pipelines/%s/updates/%s", host, pipelineId, updateId),
@@ -25,3 +23,7 @@ func (event *PipelineUpdateUrlEvent) String() string {
 func (event *PipelineUpdateUrlEvent) IsInplaceSupported() bool {
 	return false
 }
+
+func (event *PipelineUpdateUrlEvent) Type() string {
+	return "pipeline_update_url"
+}
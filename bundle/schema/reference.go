@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/databricks/cli/bundle/config"
+)
+
+// GenerateDocs writes a Markdown reference of all bundle configuration fields
+// to the writer. It is derived from the JSON schema of the bundle configuration
+// and the embedded descriptions, so that it stays current with the configuration.
+func GenerateDocs(w io.Writer) error {
+	docs, err := LoadBundleDescriptions()
+	if err != nil {
+		return err
+	}
+
+	s, err := New(reflect.TypeOf(config.Root{}), docs)
+	if err != nil {
+		return err
+	}
+
+	return s.Markdown(w, "Bundle configuration reference")
+}
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDocs(t *testing.T) {
+	var buf bytes.Buffer
+	err := GenerateDocs(&buf)
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "# Bundle configuration reference\n")
+	assert.Contains(t, out, "\n## workspace\n")
+	assert.Contains(t, out, "\n## resources\n")
+	assert.Contains(t, out, "\n## resources.jobs.\\<name\\>\n")
+	assert.Contains(t, out, "\n## resources.jobs.\\<name\\>.tasks[]\n")
+	assert.Contains(t, out, "| `host` | string |")
+
+	// The output is stable.
+	var again bytes.Buffer
+	err = GenerateDocs(&again)
+	require.NoError(t, err)
+	assert.Equal(t, out, again.String())
+}
@@ -0,0 +1,41 @@
+package config_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+func loadTargetAndFilter(t *testing.T, target string) *bundle.Bundle {
+	b := loadTarget(t, "./conditional_resources", target)
+	err := bundle.Apply(context.Background(), b, mutator.FilterResourcesByTarget())
+	require.NoError(t, err)
+	return b
+}
+
+func TestConditionalResourcesDevelopment(t *testing.T) {
+	b := loadTargetAndFilter(t, "development")
+	assert.ElementsMatch(t, []string{"daily"}, maps.Keys(b.Config.Resources.Jobs))
+	assert.ElementsMatch(t, []string{"debug"}, maps.Keys(b.Config.Resources.Pipelines))
+}
+
+func TestConditionalResourcesStaging(t *testing.T) {
+	b := loadTargetAndFilter(t, "staging")
+	assert.ElementsMatch(t, []string{"daily", "backfill"}, maps.Keys(b.Config.Resources.Jobs))
+	assert.Empty(t, b.Config.Resources.Pipelines)
+}
+
+func TestConditionalResourcesProduction(t *testing.T) {
+	b := loadTargetAndFilter(t, "production")
+	assert.ElementsMatch(t, []string{"daily", "backfill"}, maps.Keys(b.Config.Resources.Jobs))
+	assert.Empty(t, b.Config.Resources.Pipelines)
+
+	// The filtered configuration is reflected in the dynamic configuration tree.
+	_, ok := b.Config.Value().Get("resources").Get("pipelines").Get("debug").AsMap()
+	assert.False(t, ok)
+}
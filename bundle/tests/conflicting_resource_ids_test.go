@@ -3,7 +3,6 @@ package config_tests
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 	"testing"
 
 	"github.com/databricks/cli/bundle"
@@ -15,7 +14,7 @@ import (
 func TestConflictingResourceIdsNoSubconfig(t *testing.T) {
 	ctx := context.Background()
 	_, err := bundle.Load(ctx, "./conflicting_resource_ids/no_subconfigurations")
-	bundleConfigPath := filepath.FromSlash("conflicting_resource_ids/no_subconfigurations/databricks.yml")
+	bundleConfigPath := absPath(t, "conflicting_resource_ids/no_subconfigurations/databricks.yml")
 	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s, pipeline at %s)", bundleConfigPath, bundleConfigPath))
 }
 
@@ -24,8 +23,8 @@ func TestConflictingResourceIdsOneSubconfig(t *testing.T) {
 	b, err := bundle.Load(ctx, "./conflicting_resource_ids/one_subconfiguration")
 	require.NoError(t, err)
 	err = bundle.Apply(ctx, b, bundle.Seq(mutator.DefaultMutators()...))
-	bundleConfigPath := filepath.FromSlash("conflicting_resource_ids/one_subconfiguration/databricks.yml")
-	resourcesConfigPath := filepath.FromSlash("conflicting_resource_ids/one_subconfiguration/resources.yml")
+	bundleConfigPath := absPath(t, "conflicting_resource_ids/one_subconfiguration/databricks.yml")
+	resourcesConfigPath := absPath(t, "conflicting_resource_ids/one_subconfiguration/resources.yml")
 	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s, pipeline at %s)", bundleConfigPath, resourcesConfigPath))
 }
 
@@ -34,7 +33,7 @@ func TestConflictingResourceIdsTwoSubconfigs(t *testing.T) {
 	b, err := bundle.Load(ctx, "./conflicting_resource_ids/two_subconfigurations")
 	require.NoError(t, err)
 	err = bundle.Apply(ctx, b, bundle.Seq(mutator.DefaultMutators()...))
-	resources1ConfigPath := filepath.FromSlash("conflicting_resource_ids/two_subconfigurations/resources1.yml")
-	resources2ConfigPath := filepath.FromSlash("conflicting_resource_ids/two_subconfigurations/resources2.yml")
+	resources1ConfigPath := absPath(t, "conflicting_resource_ids/two_subconfigurations/resources1.yml")
+	resources2ConfigPath := absPath(t, "conflicting_resource_ids/two_subconfigurations/resources2.yml")
 	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s, pipeline at %s)", resources1ConfigPath, resources2ConfigPath))
 }
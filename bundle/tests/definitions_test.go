@@ -0,0 +1,31 @@
+package config_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefinitions(t *testing.T) {
+	b := load(t, "./definitions")
+
+	err := bundle.Apply(context.Background(), b, mutator.ResolveDefinitions())
+	require.NoError(t, err)
+
+	job := b.Config.Resources.Jobs["my_job"]
+	require.Len(t, job.JobClusters, 1)
+	assert.Equal(t, "14.3.x-scala2.12", job.JobClusters[0].NewCluster.SparkVersion)
+	assert.Equal(t, "i3.xlarge", job.JobClusters[0].NewCluster.NodeTypeId)
+	assert.Equal(t, 1, job.JobClusters[0].NewCluster.NumWorkers)
+
+	require.Len(t, job.Tasks, 2)
+	assert.Equal(t, "ingest", job.Tasks[0].TaskKey)
+	assert.Equal(t, "small", job.Tasks[0].JobClusterKey)
+	assert.Equal(t, "./ingest.py", job.Tasks[0].NotebookTask.NotebookPath)
+	assert.Equal(t, "report", job.Tasks[1].TaskKey)
+	assert.Equal(t, "i3.xlarge", job.Tasks[1].NewCluster.NodeTypeId)
+}
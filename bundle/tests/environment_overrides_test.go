@@ -33,3 +33,11 @@ func TestEnvironmentOverridesResourcesStaging(t *testing.T) {
 	assert.Equal(t, false, b.Config.Resources.Pipelines["boolean1"].Photon)
 	assert.Equal(t, true, b.Config.Resources.Pipelines["boolean2"].Photon)
 }
+
+func TestEnvironmentOverridesWorkspaceRootPathOnly(t *testing.T) {
+	b := loadTarget(t, "./environment_overrides/workspace", "production")
+
+	// Fields that are not overridden are merged from the base configuration.
+	assert.Equal(t, "https://acme.cloud.databricks.com/", b.Config.Workspace.Host)
+	assert.Equal(t, "/Shared/environment_overrides", b.Config.Workspace.RootPath)
+}
@@ -0,0 +1,37 @@
+package config_tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExperimentsAndModels(t *testing.T) {
+	b := load(t, "./experiments_and_models")
+	require.Len(t, b.Config.Resources.Experiments, 1)
+	require.Len(t, b.Config.Resources.Models, 1)
+
+	e := b.Config.Resources.Experiments["my_experiment"]
+	assert.Equal(t, absPath(t, "experiments_and_models/databricks.yml"), e.ConfigFilePath)
+	assert.Equal(t, "/Users/user@company.com/my-experiment", e.Name)
+	assert.Equal(t, "CAN_READ", e.Permissions[0].Level)
+
+	m := b.Config.Resources.Models["my_model"]
+	assert.Equal(t, absPath(t, "experiments_and_models/databricks.yml"), m.ConfigFilePath)
+	assert.Equal(t, "my-model", m.Name)
+	assert.Equal(t, "description", m.Description)
+	assert.Equal(t, "users", m.Permissions[0].GroupName)
+}
+
+func TestExperimentsAndModelsDevelopment(t *testing.T) {
+	b := loadTarget(t, "./experiments_and_models", "development")
+	assert.Equal(t, "/Users/user@company.com/my-dev-experiment", b.Config.Resources.Experiments["my_experiment"].Name)
+	assert.Equal(t, "my-dev-model", b.Config.Resources.Models["my_model"].Name)
+}
+
+func TestExperimentsAndModelsProduction(t *testing.T) {
+	b := loadTarget(t, "./experiments_and_models", "production")
+	assert.Equal(t, "/Users/user@company.com/my-experiment", b.Config.Resources.Experiments["my_experiment"].Name)
+	assert.Equal(t, "my-prod-model", b.Config.Resources.Models["my_model"].Name)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/libs/git"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGitAutoLoad(t *testing.T) {
@@ -37,3 +38,22 @@ func TestGitBundleBranchValidation(t *testing.T) {
 	err := bundle.Apply(context.Background(), b, mutator.ValidateGitDetails())
 	assert.ErrorContains(t, err, "not on the right Git branch:")
 }
+
+func TestGitDetailsInterpolation(t *testing.T) {
+	git.GitDirectoryName = ".mock-git"
+	t.Cleanup(func() {
+		git.GitDirectoryName = ".git"
+	})
+
+	b := load(t, "./git_interpolation")
+	assert.True(t, b.Config.Bundle.Git.Inferred)
+	assert.Equal(t, "feature-a", b.Config.Bundle.Git.Branch)
+	assert.Equal(t, "6f1c5e4c3a2b1d0e9f8a7b6c5d4e3f2a1b0c9d8e", b.Config.Bundle.Git.Commit)
+
+	err := bundle.Apply(context.Background(), b, mutator.ResolveVariableReferences("bundle"))
+	require.NoError(t, err)
+
+	job := b.Config.Resources.Jobs["my_job"]
+	assert.Equal(t, "Deployed from feature-a at 6f1c5e4c3a2b1d0e9f8a7b6c5d4e3f2a1b0c9d8e", job.Description)
+	assert.Equal(t, "6f1c5e4c3a2b1d0e9f8a7b6c5d4e3f2a1b0c9d8e", job.Tags["commit"])
+}
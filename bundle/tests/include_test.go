@@ -31,7 +31,7 @@ func TestIncludeWithGlob(t *testing.T) {
 
 	job := b.Config.Resources.Jobs["my_job"]
 	assert.Equal(t, "1", job.ID)
-	assert.Equal(t, "include_with_glob/job.yml", filepath.ToSlash(job.ConfigFilePath))
+	assert.Equal(t, absPath(t, "include_with_glob/job.yml"), job.ConfigFilePath)
 }
 
 func TestIncludeDefault(t *testing.T) {
@@ -51,9 +51,28 @@ func TestIncludeForMultipleMatches(t *testing.T) {
 
 	first := b.Config.Resources.Jobs["my_first_job"]
 	assert.Equal(t, "1", first.ID)
-	assert.Equal(t, "include_multiple/my_first_job/resource.yml", filepath.ToSlash(first.ConfigFilePath))
+	assert.Equal(t, absPath(t, "include_multiple/my_first_job/resource.yml"), first.ConfigFilePath)
 
 	second := b.Config.Resources.Jobs["my_second_job"]
 	assert.Equal(t, "2", second.ID)
-	assert.Equal(t, "include_multiple/my_second_job/resource.yml", filepath.ToSlash(second.ConfigFilePath))
+	assert.Equal(t, absPath(t, "include_multiple/my_second_job/resource.yml"), second.ConfigFilePath)
+}
+
+func TestIncludeMergesResourcesOfDifferentTypes(t *testing.T) {
+	b := load(t, "./include_per_team")
+
+	assert.Equal(t, []string{
+		filepath.Join("teams", "data.yml"),
+		filepath.Join("teams", "ml.yml"),
+	}, b.Config.Include)
+
+	keys := maps.Keys(b.Config.Resources.Jobs)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"shared_job", "train"}, keys)
+	assert.Equal(t, absPath(t, "include_per_team/teams/ml.yml"), b.Config.Resources.Jobs["train"].ConfigFilePath)
+
+	require.Contains(t, b.Config.Resources.Pipelines, "ingest")
+	assert.Equal(t, absPath(t, "include_per_team/teams/data.yml"), b.Config.Resources.Pipelines["ingest"].ConfigFilePath)
+
+	require.Contains(t, b.Config.Resources.Experiments, "experiment")
 }
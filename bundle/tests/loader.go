@@ -2,6 +2,7 @@ package config_tests
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/databricks/cli/bundle"
@@ -33,3 +34,11 @@ func loadTarget(t *testing.T, path, env string) *bundle.Bundle {
 	require.NoError(t, err)
 	return b
 }
+
+// absPath returns the absolute path of the specified slash-separated path
+// relative to the test directory. Configuration file paths are absolute after loading.
+func absPath(t *testing.T, path string) string {
+	abs, err := filepath.Abs(filepath.FromSlash(path))
+	require.NoError(t, err)
+	return abs
+}
@@ -0,0 +1,18 @@
+package config_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaMissingRequiredField(t *testing.T) {
+	b := load(t, "./schema_missing_required_field")
+
+	// The schema is validated before the workspace client is initialized.
+	err := bundle.Apply(context.Background(), b, phases.Initialize())
+	assert.ErrorContains(t, err, `resources.registered_models.my_model: missing required field "schema_name"`)
+}
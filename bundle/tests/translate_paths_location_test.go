@@ -0,0 +1,17 @@
+package config_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslatePathsErrorIncludesLocation(t *testing.T) {
+	b := load(t, "./translate_paths_location")
+
+	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	assert.EqualError(t, err, "notebook ./doesnt_exist.py not found (defined at resources/jobs.yml:6)")
+}
@@ -120,3 +120,25 @@ func TestVariablesWithTargetLookupOverrides(t *testing.T) {
 	assert.Equal(t, "cluster: some-test-cluster", b.Config.Variables["d"].Lookup.String())
 	assert.Equal(t, "instance-pool: some-test-instance-pool", b.Config.Variables["e"].Lookup.String())
 }
+
+func TestVariablesInResources(t *testing.T) {
+	t.Setenv("BUNDLE_VAR_node_type", "m5.large")
+	b := load(t, "./variables/resources")
+	err := bundle.ApplyFunc(context.Background(), b, func(ctx context.Context, b *bundle.Bundle) error {
+		return b.Config.InitializeVariables([]string{"env=prod"})
+	})
+	require.NoError(t, err)
+
+	err = bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		mutator.ResolveVariableReferences(
+			"variables",
+		),
+	))
+	require.NoError(t, err)
+
+	job := b.Config.Resources.Jobs["my_job"]
+	assert.Equal(t, "[prod] my job", job.Name)
+	assert.Equal(t, "m5.large", job.Tasks[0].NewCluster.NodeTypeId)
+	assert.Equal(t, "./notebooks/main.py", job.Tasks[0].NotebookTask.NotebookPath)
+}
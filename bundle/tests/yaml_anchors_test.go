@@ -1,8 +1,10 @@
 package config_tests
 
 import (
+	"context"
 	"testing"
 
+	"github.com/databricks/cli/bundle"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,3 +24,21 @@ func TestYAMLAnchors(t *testing.T) {
 	assert.Equal(t, "10.4.x-scala2.12", t0.NewCluster.SparkVersion)
 	assert.Equal(t, "10.4.x-scala2.12", t1.NewCluster.SparkVersion)
 }
+
+func TestYAMLAnchorsAreIsolated(t *testing.T) {
+	b := load(t, "./yaml_anchors")
+
+	// Modify the cluster of the first task from a mutator.
+	err := bundle.ApplyFunc(context.Background(), b, func(ctx context.Context, b *bundle.Bundle) error {
+		b.Config.Resources.Jobs["my_job"].Tasks[0].NewCluster.SparkVersion = "13.3.x-scala2.12"
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The cluster of the second task must not be affected.
+	j := b.Config.Resources.Jobs["my_job"]
+	require.Len(t, j.Tasks, 2)
+	assert.Equal(t, "13.3.x-scala2.12", j.Tasks[0].NewCluster.SparkVersion)
+	assert.Equal(t, "10.4.x-scala2.12", j.Tasks[1].NewCluster.SparkVersion)
+	assert.NotSame(t, j.Tasks[0].NewCluster, j.Tasks[1].NewCluster)
+}
@@ -14,9 +14,11 @@ func New() *cobra.Command {
 	}
 
 	initVariableFlag(cmd)
+	initStrictFlag(cmd)
 	cmd.AddCommand(newDeployCommand())
 	cmd.AddCommand(newDestroyCommand())
 	cmd.AddCommand(newLaunchCommand())
+	cmd.AddCommand(newLintCommand())
 	cmd.AddCommand(newRunCommand())
 	cmd.AddCommand(newSchemaCommand())
 	cmd.AddCommand(newSyncCommand())
@@ -18,14 +18,22 @@ func newDeployCommand() *cobra.Command {
 		PreRunE: utils.ConfigureBundleWithVariables,
 	}
 
+	initResourceFilterFlags(cmd)
+
 	var force bool
 	var forceLock bool
 	var failOnActiveRuns bool
 	var computeID string
+	var dryRun bool
+	var forceUpload bool
+	var forceUpdate bool
 	cmd.Flags().BoolVar(&force, "force", false, "Force-override Git branch validation.")
 	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
 	cmd.Flags().BoolVar(&failOnActiveRuns, "fail-on-active-runs", false, "Fail if there are running jobs or pipelines in the deployment.")
 	cmd.Flags().StringVarP(&computeID, "compute-id", "c", "", "Override compute in the deployment with the given compute ID.")
+	cmd.Flags().BoolVar(&forceUpload, "force-upload", false, "Upload modified files, even if their content is unchanged since the last deployment.")
+	cmd.Flags().BoolVar(&forceUpdate, "force-update", false, "Update all resources, including resources whose configuration did not change since the last deployment.")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes the deployment would make without making them.")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
@@ -34,6 +42,8 @@ func newDeployCommand() *cobra.Command {
 		bundle.ApplyFunc(ctx, b, func(context.Context, *bundle.Bundle) error {
 			b.Config.Bundle.Force = force
 			b.Config.Bundle.Deployment.Lock.Force = forceLock
+			b.Config.Bundle.ForceUpload = forceUpload
+			b.Config.Bundle.ForceUpdate = forceUpdate
 			if cmd.Flag("compute-id").Changed {
 				b.Config.Bundle.ComputeID = computeID
 			}
@@ -45,6 +55,14 @@ func newDeployCommand() *cobra.Command {
 			return nil
 		})
 
+		if dryRun {
+			return bundle.Apply(ctx, b, bundle.Seq(
+				phases.Initialize(),
+				phases.Build(),
+				phases.Plan(),
+			))
+		}
+
 		return bundle.Apply(ctx, b, bundle.Seq(
 			phases.Initialize(),
 			phases.Build(),
@@ -13,5 +13,7 @@ func NewDeploymentCommand() *cobra.Command {
 
 	cmd.AddCommand(newBindCommand())
 	cmd.AddCommand(newUnbindCommand())
+	cmd.AddCommand(newUnlockCommand())
+	cmd.AddCommand(newDriftCommand())
 	return cmd
 }
@@ -0,0 +1,131 @@
+package deployment
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
+	"github.com/databricks/cli/bundle/deploy/drift"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/spf13/cobra"
+)
+
+func newDriftCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detect changes made to deployed resources outside of the bundle",
+		Long: `Detect changes made to deployed resources outside of the bundle.
+
+Compares the settings of the deployed jobs and pipelines in the workspace
+to the bundle configuration and reports fields that were changed, for example
+through the UI, as well as resources that were deleted. Run 'bundle deploy'
+to overwrite these changes, or update the configuration to keep them. With the
+direct deployment backend, use 'bundle deploy --force-update' to overwrite
+changes to resources whose configuration did not change.
+
+Fields that are not set in the configuration are not compared.
+The command exits with an error if drift is detected.`,
+		Args:    root.NoArgs,
+		PreRunE: utils.ConfigureBundleWithVariables,
+	}
+
+	var format string
+	cmd.Flags().StringVar(&format, "format", "text", "Format of the report. Supported values: text, json.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+		if format != "text" && format != "json" {
+			return fmt.Errorf("unsupported format %q: must be one of text, json", format)
+		}
+
+		err := bundle.Apply(ctx, b, bundle.Seq(
+			phases.Initialize(),
+			bundle.If(
+				direct.IsEnabled,
+				bundle.Seq(
+					direct.StatePull(),
+					direct.Load(),
+				),
+				bundle.Seq(
+					terraform.Interpolate(),
+					terraform.Write(),
+					terraform.StatePull(),
+					terraform.Load(terraform.ErrorOnEmptyState),
+				),
+			),
+		))
+		if err != nil {
+			return err
+		}
+
+		resources, err := drift.Detect(ctx, b)
+		if err != nil {
+			return err
+		}
+
+		if format == "json" {
+			err = writeDriftJSON(cmd.OutOrStdout(), resources)
+			if err != nil {
+				return err
+			}
+		} else {
+			writeDriftText(cmd.OutOrStdout(), resources)
+		}
+
+		if len(resources) > 0 {
+			return fmt.Errorf("found drift in %d resource(s)", len(resources))
+		}
+		return nil
+	}
+
+	return cmd
+}
+
+func writeDriftJSON(w io.Writer, resources []drift.Resource) error {
+	if resources == nil {
+		resources = []drift.Resource{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resources)
+}
+
+func writeDriftText(w io.Writer, resources []drift.Resource) {
+	if len(resources) == 0 {
+		fmt.Fprintln(w, "No drift detected")
+		return
+	}
+
+	for _, r := range resources {
+		if r.Deleted {
+			fmt.Fprintf(w, "%s %s (%s) was deleted in the workspace\n", r.Type, r.Key, r.ID)
+			continue
+		}
+
+		fmt.Fprintf(w, "%s %s (%s) was changed in the workspace:\n", r.Type, r.Key, r.ID)
+		for _, f := range r.Fields {
+			switch {
+			case f.Bundle == nil:
+				fmt.Fprintf(w, "  + %s: %s\n", f.Path, formatValue(f.Workspace))
+			case f.Workspace == nil:
+				fmt.Fprintf(w, "  - %s: %s\n", f.Path, formatValue(f.Bundle))
+			default:
+				fmt.Fprintf(w, "  ~ %s: %s -> %s\n", f.Path, formatValue(f.Bundle), formatValue(f.Workspace))
+			}
+		}
+	}
+}
+
+func formatValue(v any) string {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(buf)
+}
@@ -0,0 +1,34 @@
+package deployment
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/lock"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/spf13/cobra"
+)
+
+func newUnlockCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock",
+		Short: "Release the deployment lock of the bundle",
+		Long: `Release the deployment lock of the bundle irrespective of who holds it.
+
+Use this to recover from a deployment that failed to release its lock,
+for example because it was interrupted. Make sure no other deployment
+of the bundle is in progress before releasing its lock.`,
+		Args:    root.NoArgs,
+		PreRunE: utils.ConfigureBundleWithVariables,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		b := bundle.Get(cmd.Context())
+		return bundle.Apply(cmd.Context(), b, bundle.Seq(
+			phases.Initialize(),
+			lock.ForceRelease(),
+		))
+	}
+
+	return cmd
+}
@@ -53,8 +53,8 @@ func newDestroyCommand() *cobra.Command {
 		if !ok {
 			return fmt.Errorf("progress logger not found")
 		}
-		if logger.Mode == flags.ModeJson && !autoApprove {
-			return fmt.Errorf("please specify --auto-approve since selected logging format is json")
+		if (logger.Mode == flags.ModeJson || logger.Mode == flags.ModeNdjson) && !autoApprove {
+			return fmt.Errorf("please specify --auto-approve since selected logging format is %s", logger.Mode)
 		}
 
 		return bundle.Apply(ctx, b, bundle.Seq(
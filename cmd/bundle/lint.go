@@ -0,0 +1,79 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/lint"
+	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/spf13/cobra"
+)
+
+func newLintCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lint",
+		Short:   "Check configuration for common misconfigurations",
+		Args:    root.NoArgs,
+		PreRunE: utils.ConfigureBundleWithVariables,
+	}
+
+	var format string
+	cmd.Flags().StringVar(&format, "format", "text", "Format of the findings. Supported values: text, json.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		b := bundle.Get(cmd.Context())
+		if format != "text" && format != "json" {
+			return fmt.Errorf("unsupported format %q: must be one of text, json", format)
+		}
+
+		diags := validate.Validate(cmd.Context(), b, lint.Rules()...)
+		if format == "json" {
+			err := writeDiagnosticsJSON(cmd.OutOrStdout(), diags)
+			if err != nil {
+				return err
+			}
+		} else {
+			renderDiagnostics(cmd.OutOrStdout(), diags)
+		}
+
+		if len(diags) > 0 {
+			return fmt.Errorf("found %d issue(s) in bundle configuration", len(diags))
+		}
+		return nil
+	}
+
+	return cmd
+}
+
+type jsonDiagnostic struct {
+	Severity string `json:"severity"`
+	Summary  string `json:"summary"`
+	Path     string `json:"path,omitempty"`
+	Location string `json:"location,omitempty"`
+}
+
+// writeDiagnosticsJSON writes the diagnostics as a JSON array.
+func writeDiagnosticsJSON(w io.Writer, diags diag.Diagnostics) error {
+	out := make([]jsonDiagnostic, 0, len(diags))
+	for _, d := range diags {
+		jd := jsonDiagnostic{
+			Severity: strings.ToLower(severityString(d.Severity)),
+			Summary:  d.Summary,
+			Path:     d.Path.String(),
+		}
+		if d.Location.File != "" {
+			jd.Location = d.Location.String()
+		}
+		out = append(out, jd)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
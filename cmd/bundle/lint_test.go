@@ -0,0 +1,47 @@
+package bundle
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteDiagnosticsJSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeDiagnosticsJSON(&buf, diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "target is not set",
+			Path:     dyn.NewPath(dyn.Key("resources"), dyn.Key("pipelines"), dyn.Key("etl")),
+			Location: dyn.Location{File: "databricks.yml", Line: 3, Column: 5},
+		},
+		{
+			Severity: diag.Error,
+			Summary:  "something is wrong",
+		},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{
+			"severity": "warning",
+			"summary": "target is not set",
+			"path": "resources.pipelines.etl",
+			"location": "databricks.yml:3:5"
+		},
+		{
+			"severity": "error",
+			"summary": "something is wrong"
+		}
+	]`, buf.String())
+}
+
+func TestWriteDiagnosticsJSONEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeDiagnosticsJSON(&buf, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, buf.String())
+}
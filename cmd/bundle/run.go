@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/direct"
 	"github.com/databricks/cli/bundle/deploy/terraform"
 	"github.com/databricks/cli/bundle/phases"
 	"github.com/databricks/cli/bundle/run"
@@ -23,6 +24,8 @@ func newRunCommand() *cobra.Command {
 		PreRunE: utils.ConfigureBundleWithVariables,
 	}
 
+	initResourceFilterFlags(cmd)
+
 	var runOptions run.Options
 	runOptions.Define(cmd)
 
@@ -37,10 +40,19 @@ func newRunCommand() *cobra.Command {
 
 		err := bundle.Apply(ctx, b, bundle.Seq(
 			phases.Initialize(),
-			terraform.Interpolate(),
-			terraform.Write(),
-			terraform.StatePull(),
-			terraform.Load(terraform.ErrorOnEmptyState),
+			bundle.If(
+				direct.IsEnabled,
+				bundle.Seq(
+					direct.StatePull(),
+					direct.Load(),
+				),
+				bundle.Seq(
+					terraform.Interpolate(),
+					terraform.Write(),
+					terraform.StatePull(),
+					terraform.Load(terraform.ErrorOnEmptyState),
+				),
+			),
 		))
 		if err != nil {
 			return err
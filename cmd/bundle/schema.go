@@ -2,11 +2,13 @@ package bundle
 
 import (
 	"encoding/json"
+	"fmt"
 	"reflect"
 
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/schema"
 	"github.com/databricks/cli/cmd/root"
+	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
 )
 
@@ -17,7 +19,14 @@ func newSchemaCommand() *cobra.Command {
 		Args:  root.NoArgs,
 	}
 
+	var format string
+	cmd.Flags().StringVar(&format, "format", "json", "Format of the schema. Supported values: json, yaml.")
+
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if format != "json" && format != "yaml" {
+			return fmt.Errorf("unsupported format %q: must be one of json, yaml", format)
+		}
+
 		// Load embedded schema descriptions.
 		docs, err := schema.LoadBundleDescriptions()
 		if err != nil {
@@ -35,8 +44,14 @@ func newSchemaCommand() *cobra.Command {
 		if err != nil {
 			return err
 		}
-		cmd.OutOrStdout().Write(result)
-		return nil
+		if format == "yaml" {
+			result, err = yaml.JSONToYAML(result)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = cmd.OutOrStdout().Write(result)
+		return err
 	}
 
 	return cmd
@@ -0,0 +1,37 @@
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runSchemaCommand(t *testing.T, args ...string) (string, error) {
+	var buf bytes.Buffer
+	cmd := newSchemaCommand()
+	cmd.SetOut(&buf)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.Background())
+	return buf.String(), err
+}
+
+func TestSchemaCommandJSON(t *testing.T) {
+	out, err := runSchemaCommand(t)
+	require.NoError(t, err)
+	assert.Contains(t, out, `"additionalProperties": false`)
+}
+
+func TestSchemaCommandYAML(t *testing.T) {
+	out, err := runSchemaCommand(t, "--format", "yaml")
+	require.NoError(t, err)
+	assert.Contains(t, out, "additionalProperties: false")
+	assert.Contains(t, out, "description:")
+}
+
+func TestSchemaCommandInvalidFormat(t *testing.T) {
+	_, err := runSchemaCommand(t, "--format", "xml")
+	assert.EqualError(t, err, `unsupported format "xml": must be one of json, yaml`)
+}
@@ -2,9 +2,14 @@ package utils
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn/convert"
 	"github.com/spf13/cobra"
 )
 
@@ -20,9 +25,75 @@ func ConfigureBundleWithVariables(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Initialize variables by assigning them values passed as command line flags
+	noStrict, err := cmd.Flags().GetBool("no-strict")
+	if err != nil {
+		return err
+	}
+
+	// Unknown keys are reported as warnings when the configuration is loaded.
+	// Unless strict mode is disabled they are errors, such that misspelled
+	// keys are not silently ignored.
 	b := bundle.Get(cmd.Context())
-	return bundle.ApplyFunc(cmd.Context(), b, func(ctx context.Context, b *bundle.Bundle) error {
+	if !noStrict {
+		err = unknownFieldsAsError(b.Config.Diagnostics())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Initialize variables by assigning them values passed as command line flags
+	err = bundle.ApplyFunc(cmd.Context(), b, func(ctx context.Context, b *bundle.Bundle) error {
 		return b.Config.InitializeVariables(variables)
 	})
+	if err != nil {
+		return err
+	}
+
+	return filterResources(cmd, b)
+}
+
+// filterResources selects the resources to operate on for commands
+// that define the --only and --exclude flags.
+func filterResources(cmd *cobra.Command, b *bundle.Bundle) error {
+	if cmd.Flags().Lookup("only") == nil {
+		return nil
+	}
+
+	only, err := cmd.Flags().GetStringSlice("only")
+	if err != nil {
+		return err
+	}
+
+	exclude, err := cmd.Flags().GetStringSlice("exclude")
+	if err != nil {
+		return err
+	}
+
+	err = bundle.ApplyFunc(cmd.Context(), b, func(ctx context.Context, b *bundle.Bundle) error {
+		b.Config.Bundle.Only = only
+		b.Config.Bundle.Exclude = exclude
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return bundle.Apply(cmd.Context(), b, mutator.FilterResources())
+}
+
+// unknownFieldsAsError returns an error that describes all unknown fields reported in diags, if any.
+// Other warnings are not affected by strict mode.
+func unknownFieldsAsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if !convert.IsUnknownField(d) {
+			continue
+		}
+		if d.Location.File != "" {
+			errs = append(errs, fmt.Errorf("%s (%s)", d.Summary, d.Location))
+		} else {
+			errs = append(errs, errors.New(d.Summary))
+		}
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknownFieldsAsError(t *testing.T) {
+	err := unknownFieldsAsError(diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "unknown field: resource (did you mean resources?)",
+			Location: dyn.Location{File: "databricks.yml", Line: 1, Column: 1},
+		},
+		{
+			Severity: diag.Info,
+			Summary:  "nothing to see",
+		},
+		{
+			Severity: diag.Warning,
+			Summary:  "job name \"foo\" is used by multiple jobs: a, b",
+		},
+		{
+			Severity: diag.Warning,
+			Summary:  "unknown field: taget (did you mean target?)",
+		},
+	})
+	assert.EqualError(t, err, "unknown field: resource (did you mean resources?) (databricks.yml:1:1)\nunknown field: taget (did you mean target?)")
+}
+
+func TestUnknownFieldsAsErrorWithoutUnknownFields(t *testing.T) {
+	err := unknownFieldsAsError(diag.Diagnostics{
+		{Severity: diag.Info, Summary: "nothing to see"},
+		{Severity: diag.Warning, Summary: "something to see"},
+	})
+	assert.NoError(t, err)
+}
@@ -1,13 +1,18 @@
 package bundle
 
 import (
-	"encoding/json"
+	"fmt"
+	"io"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/validate"
 	"github.com/databricks/cli/bundle/phases"
 	"github.com/databricks/cli/cmd/bundle/utils"
 	"github.com/databricks/cli/cmd/root"
-	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/flags"
 	"github.com/spf13/cobra"
 )
 
@@ -19,27 +24,90 @@ func newValidateCommand() *cobra.Command {
 		PreRunE: utils.ConfigureBundleWithVariables,
 	}
 
+	initResourceFilterFlags(cmd)
+
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		b := bundle.Get(cmd.Context())
 
-		err := bundle.Apply(cmd.Context(), b, phases.Initialize())
+		// Target overrides and workspace defaults fill in required fields.
+		err := bundle.Apply(cmd.Context(), b, bundle.Seq(
+			mutator.MergeJobClusters(),
+			mutator.MergeJobTasks(),
+			mutator.MergePipelineClusters(),
+			mutator.ApplyWorkspaceDefaults(),
+		))
 		if err != nil {
 			return err
 		}
 
-		// Until we change up the output of this command to be a text representation,
-		// we'll just output all diagnostics as debug logs.
-		for _, diag := range b.Config.Diagnostics() {
-			log.Debugf(cmd.Context(), "[%s]: %s", diag.Location, diag.Summary)
+		// Collect all problems with the configuration before initializing,
+		// such that they can be reported together instead of one at a time.
+		diags := validate.Validate(cmd.Context(), b)
+		renderDiagnostics(cmd.ErrOrStderr(), diags)
+		if diags.HasError() {
+			return fmt.Errorf("found %d error(s) in bundle configuration", countErrors(diags))
 		}
 
-		buf, err := json.MarshalIndent(b.Config, "", "  ")
+		err = bundle.Apply(cmd.Context(), b, phases.Initialize())
 		if err != nil {
 			return err
 		}
-		cmd.OutOrStdout().Write(buf)
-		return nil
+
+		return writeConfig(cmd.OutOrStdout(), &b.Config, root.OutputType(cmd))
 	}
 
 	return cmd
 }
+
+// writeConfig writes the resolved configuration in the specified output format.
+// The configuration is written as JSON unless YAML is requested, such that
+// both the text and JSON output types produce the same output.
+// Empty values are omitted from either format.
+// Values of resolved secrets are redacted.
+func writeConfig(w io.Writer, c *config.Root, output flags.Output) error {
+	if output == flags.OutputYAML {
+		return c.WriteYAML(w)
+	}
+
+	buf, err := c.ExportJSON()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
+func renderDiagnostics(w io.Writer, diags diag.Diagnostics) {
+	for _, group := range validate.GroupByPath(diags) {
+		if len(group.Path) > 0 {
+			fmt.Fprintf(w, "%s:\n", group.Path)
+		}
+		for _, d := range group.Diagnostics {
+			fmt.Fprintf(w, "  %s: %s\n", severityString(d.Severity), d.Summary)
+			if len(d.Path) > 0 && d.Location.File != "" {
+				fmt.Fprintf(w, "    at %s (%s)\n", d.Path, d.Location)
+			}
+		}
+	}
+}
+
+func severityString(s diag.Severity) string {
+	switch s {
+	case diag.Error:
+		return "Error"
+	case diag.Warning:
+		return "Warning"
+	default:
+		return "Info"
+	}
+}
+
+func countErrors(diags diag.Diagnostics) int {
+	n := 0
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,35 @@
+package bundle
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteConfigJSON(t *testing.T) {
+	var buf bytes.Buffer
+	c := &config.Root{Bundle: config.Bundle{Name: "foo"}}
+	err := writeConfig(&buf, c, flags.OutputJSON)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `"name": "foo"`)
+}
+
+func TestWriteConfigText(t *testing.T) {
+	var buf bytes.Buffer
+	c := &config.Root{Bundle: config.Bundle{Name: "foo"}}
+	err := writeConfig(&buf, c, flags.OutputText)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `"name": "foo"`)
+}
+
+func TestWriteConfigYAML(t *testing.T) {
+	var buf bytes.Buffer
+	c := &config.Root{Bundle: config.Bundle{Name: "foo"}}
+	err := writeConfig(&buf, c, flags.OutputYAML)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "name: foo")
+}
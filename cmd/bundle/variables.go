@@ -7,3 +7,12 @@ import (
 func initVariableFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringSlice("var", []string{}, `set values for variables defined in bundle config. Example: --var="foo=bar"`)
 }
+
+func initStrictFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool("no-strict", false, `report unknown keys in bundle config as warnings instead of errors`)
+}
+
+func initResourceFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("only", []string{}, `only operate on the specified resources and the resources they refer to. Example: --only job:my_job`)
+	cmd.Flags().StringSlice("exclude", []string{}, `do not operate on the specified resources. Example: --exclude pipeline:etl`)
+}
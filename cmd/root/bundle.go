@@ -102,7 +102,7 @@ func configureBundle(cmd *cobra.Command, args []string, load func(ctx context.Co
 	}
 
 	ctx := cmd.Context()
-	err = bundle.Apply(ctx, b, m)
+	err = bundle.Apply(ctx, b, bundle.Seq(append([]bundle.Mutator{m}, mutator.TargetMutators()...)...))
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,12 @@
 package root
 
 import (
+	"context"
+	"maps"
+	"text/template"
+
 	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/databrickscfg"
 	"github.com/databricks/cli/libs/env"
 	"github.com/databricks/cli/libs/flags"
 	"github.com/spf13/cobra"
@@ -9,25 +14,69 @@ import (
 
 const envOutputFormat = "DATABRICKS_OUTPUT_FORMAT"
 
+// configOutputFormat is the key in the [DEFAULT] section of the
+// Databricks configuration file that configures the output format.
+const configOutputFormat = "output_format"
+
 type outputFlag struct {
-	output flags.Output
+	output   flags.Output
+	color    flags.ColorMode
+	noHeader bool
 }
 
 func initOutputFlag(cmd *cobra.Command) *outputFlag {
 	f := outputFlag{
 		output: flags.OutputText,
+		color:  flags.NewColorMode(),
 	}
 
-	// Configure defaults from environment, if applicable.
-	// If the provided value is invalid it is ignored.
+	// Configure defaults from the configuration file and the environment, if applicable.
+	// The environment takes precedence over the configuration file, and the flag
+	// takes precedence over both. If the provided value is invalid it is ignored.
+	if v, ok := outputFormatFromConfig(cmd.Context()); ok {
+		f.output.Set(v)
+	}
 	if v, ok := env.Lookup(cmd.Context(), envOutputFormat); ok {
 		f.output.Set(v)
 	}
 
-	cmd.PersistentFlags().VarP(&f.output, "output", "o", "output type: text or json")
+	cmd.PersistentFlags().VarP(&f.output, "output", "o", "output type: text, json or yaml (yaml is only supported by some commands)")
+	cmd.PersistentFlags().Var(&f.color, "color", "when to use colored output: auto, always or never")
+	cmd.RegisterFlagCompletionFunc("color", f.color.Complete)
+	cmd.PersistentFlags().BoolVar(&f.noHeader, "no-header", false, "omit the header row from table output")
 	return &f
 }
 
+// outputFormatFromConfig returns the output format configured in the
+// Databricks configuration file, if any.
+func outputFormatFromConfig(ctx context.Context) (string, bool) {
+	configFile, err := databrickscfg.Get(ctx)
+	if err != nil {
+		return "", false
+	}
+	section := configFile.Section("DEFAULT")
+	if !section.HasKey(configOutputFormat) {
+		return "", false
+	}
+	return section.Key(configOutputFormat).String(), true
+}
+
+// templateFuncs holds the template functions registered for specific commands.
+var templateFuncs = make(map[*cobra.Command]template.FuncMap)
+
+// RegisterTemplateFuncs registers functions for use in the templates of the
+// specified command, for example from a command override in an init function.
+// The functions are only available to this command and take precedence
+// over the functions that are available to all templates.
+func RegisterTemplateFuncs(cmd *cobra.Command, funcs template.FuncMap) {
+	m, ok := templateFuncs[cmd]
+	if !ok {
+		m = make(template.FuncMap)
+		templateFuncs[cmd] = m
+	}
+	maps.Copy(m, funcs)
+}
+
 func OutputType(cmd *cobra.Command) flags.Output {
 	f, ok := cmd.Flag("output").Value.(*flags.Output)
 	if !ok {
@@ -46,6 +95,9 @@ func (f *outputFlag) initializeIO(cmd *cobra.Command) error {
 	}
 
 	cmdIO := cmdio.NewIO(f.output, cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr(), headerTemplate, template)
+	cmdIO.SetColorMode(f.color)
+	cmdIO.SetTemplateFuncs(templateFuncs[cmd])
+	cmdIO.SetNoHeader(f.noHeader)
 	ctx := cmdio.InContext(cmd.Context(), cmdIO)
 	cmd.SetContext(ctx)
 	return nil
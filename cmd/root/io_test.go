@@ -0,0 +1,116 @@
+package root
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/env"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterTemplateFuncs(t *testing.T) {
+	newCommand := func() (*cobra.Command, *bytes.Buffer) {
+		var out bytes.Buffer
+		cmd := &cobra.Command{
+			Annotations: map[string]string{
+				"template": `{{shout .}}`,
+			},
+		}
+		cmd.SetOut(&out)
+		cmd.SetContext(context.Background())
+		return cmd, &out
+	}
+
+	cmd, out := newCommand()
+	RegisterTemplateFuncs(cmd, template.FuncMap{
+		"shout": func(s string) string {
+			return strings.ToUpper(s) + "!"
+		},
+	})
+	err := initOutputFlag(cmd).initializeIO(cmd)
+	require.NoError(t, err)
+	err = cmdio.Render(cmd.Context(), "hello")
+	require.NoError(t, err)
+	assert.Equal(t, "HELLO!", out.String())
+
+	// Functions are scoped to the command they are registered for.
+	other, _ := newCommand()
+	err = initOutputFlag(other).initializeIO(other)
+	require.NoError(t, err)
+	err = cmdio.Render(other.Context(), "hello")
+	assert.ErrorContains(t, err, `function "shout" not defined`)
+}
+
+func TestOutputFormatResolution(t *testing.T) {
+	dir := t.TempDir()
+	withConfig := filepath.Join(dir, "with-output-format")
+	err := os.WriteFile(withConfig, []byte("[DEFAULT]\noutput_format = json\n"), 0644)
+	require.NoError(t, err)
+	withoutConfig := filepath.Join(dir, "does-not-exist")
+
+	for _, c := range []struct {
+		name     string
+		config   string
+		env      string
+		args     []string
+		expected flags.Output
+	}{
+		{"default", withoutConfig, "", nil, flags.OutputText},
+		{"config", withConfig, "", nil, flags.OutputJSON},
+		{"env over config", withConfig, "text", nil, flags.OutputText},
+		{"flag over env", withoutConfig, "json", []string{"--output", "text"}, flags.OutputText},
+		{"flag over config", withConfig, "", []string{"-o", "text"}, flags.OutputText},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := env.Set(context.Background(), "DATABRICKS_CONFIG_FILE", c.config)
+			if c.env != "" {
+				ctx = env.Set(ctx, envOutputFormat, c.env)
+			}
+
+			cmd := &cobra.Command{}
+			cmd.SetContext(ctx)
+			f := initOutputFlag(cmd)
+			err := cmd.ParseFlags(c.args)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, f.output)
+		})
+	}
+}
+
+func TestNoHeaderFlag(t *testing.T) {
+	for _, c := range []struct {
+		args     []string
+		expected string
+	}{
+		{nil, "ID\n1"},
+		{[]string{"--no-header"}, "1"},
+	} {
+		var out bytes.Buffer
+		cmd := &cobra.Command{
+			Annotations: map[string]string{
+				"headerTemplate": `{{header "ID"}}`,
+				"template":       `{{range .}}{{.}}{{end}}`,
+			},
+		}
+		cmd.SetOut(&out)
+		cmd.SetContext(context.Background())
+		f := initOutputFlag(cmd)
+		err := cmd.ParseFlags(c.args)
+		require.NoError(t, err)
+		err = f.initializeIO(cmd)
+		require.NoError(t, err)
+
+		err = cmdio.Render(cmd.Context(), []int{1})
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, out.String())
+	}
+}
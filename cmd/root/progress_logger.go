@@ -3,13 +3,11 @@ package root
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/databricks/cli/libs/cmdio"
-	"github.com/databricks/cli/libs/env"
 	"github.com/databricks/cli/libs/flags"
 	"github.com/spf13/cobra"
-	"golang.org/x/term"
+	"github.com/spf13/pflag"
 )
 
 const envProgressFormat = "DATABRICKS_CLI_PROGRESS_FORMAT"
@@ -17,48 +15,71 @@ const envProgressFormat = "DATABRICKS_CLI_PROGRESS_FORMAT"
 type progressLoggerFlag struct {
 	flags.ProgressLogFormat
 
+	// Minimum level of the progress events to emit.
+	level flags.LogLevel
+
 	log *logFlags
+
+	// Error from parsing the progress format in the environment, if any.
+	// It is returned when initializing the context, unless the flag is set.
+	envErr error
+
+	flag *pflag.Flag
 }
 
 func (f *progressLoggerFlag) resolveModeDefault(format flags.ProgressLogFormat) flags.ProgressLogFormat {
-	if (f.log.level.String() == "disabled" || f.log.file.String() != "stderr") &&
-		term.IsTerminal(int(os.Stderr.Fd())) {
-		return flags.ModeInplace
+	// Inplace logging interferes with logs written to stderr.
+	if f.log.level.String() != "disabled" && f.log.file.String() == "stderr" {
+		return flags.ModeAppend
 	}
-	return flags.ModeAppend
+	// The logger resolves this to inplace or append depending on the terminal.
+	return flags.ModeAuto
 }
 
 func (f *progressLoggerFlag) initializeContext(ctx context.Context) (context.Context, error) {
+	if f.envErr != nil && !f.flag.Changed {
+		return nil, f.envErr
+	}
+
 	if f.log.level.String() != "disabled" && f.log.file.String() == "stderr" &&
 		f.ProgressLogFormat == flags.ModeInplace {
 		return nil, fmt.Errorf("inplace progress logging cannot be used when log-file is stderr")
 	}
 
 	format := f.ProgressLogFormat
-	if format == flags.ModeDefault {
+	if format == flags.ModeDefault || format == flags.ModeAuto {
 		format = f.resolveModeDefault(format)
 	}
 
 	progressLogger := cmdio.NewLogger(format)
+	progressLogger.Level = f.level
 	return cmdio.NewContext(ctx, progressLogger), nil
 }
 
 func initProgressLoggerFlag(cmd *cobra.Command, logFlags *logFlags) *progressLoggerFlag {
 	f := progressLoggerFlag{
 		ProgressLogFormat: flags.NewProgressLogFormat(),
+		level:             flags.LogLevelInfo,
 
 		log: logFlags,
 	}
 
 	// Configure defaults from environment, if applicable.
-	// If the provided value is invalid it is ignored.
-	if v, ok := env.Lookup(cmd.Context(), envProgressFormat); ok {
-		f.Set(v)
+	// If the provided value is invalid it is reported when initializing the context.
+	if v, err := flags.ProgressLogFormatFromEnv(cmd.Context(), envProgressFormat); err != nil {
+		f.envErr = err
+	} else {
+		f.ProgressLogFormat = v
 	}
 
 	flags := cmd.PersistentFlags()
-	flags.Var(&f.ProgressLogFormat, "progress-format", "format for progress logs (append, inplace, json)")
+	flags.Var(&f.ProgressLogFormat, "progress-format", "format for progress logs (auto, append, inplace, json, ndjson, none)")
 	flags.MarkHidden("progress-format")
+	f.flag = flags.Lookup("progress-format")
 	cmd.RegisterFlagCompletionFunc("progress-format", f.ProgressLogFormat.Complete)
+
+	flags.Var(&f.level, "progress-level", "minimum level of progress events (debug, info, warn, error)")
+	flags.MarkHidden("progress-level")
+	cmd.RegisterFlagCompletionFunc("progress-level", f.level.Complete)
 	return &f
 }
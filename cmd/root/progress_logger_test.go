@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/env"
 	"github.com/databricks/cli/libs/flags"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -60,10 +61,69 @@ func TestNoErrorOnNonStderrLogFile(t *testing.T) {
 
 func TestDefaultLoggerModeResolution(t *testing.T) {
 	plt, _, _, progressFormat := initializeProgressLoggerTest(t)
-	require.Equal(t, *progressFormat, flags.ModeDefault)
+	require.Equal(t, *progressFormat, flags.ModeAuto)
 	ctx, err := plt.progressLoggerFlag.initializeContext(context.Background())
 	require.NoError(t, err)
 	logger, ok := cmdio.FromContext(ctx)
 	assert.True(t, ok)
 	assert.Equal(t, logger.Mode, flags.ModeAppend)
 }
+
+func TestProgressFormatFromEnvironment(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetContext(env.Set(context.Background(), envProgressFormat, "json"))
+	logFlags := initLogFlags(cmd)
+	f := initProgressLoggerFlag(cmd, logFlags)
+	assert.Equal(t, flags.ModeJson, f.ProgressLogFormat)
+
+	ctx, err := f.initializeContext(context.Background())
+	require.NoError(t, err)
+	logger, ok := cmdio.FromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, flags.ModeJson, logger.Mode)
+}
+
+func TestInvalidProgressFormatFromEnvironment(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetContext(env.Set(context.Background(), envProgressFormat, "foo"))
+	logFlags := initLogFlags(cmd)
+	f := initProgressLoggerFlag(cmd, logFlags)
+	assert.Equal(t, flags.ModeAuto, f.ProgressLogFormat)
+
+	_, err := f.initializeContext(context.Background())
+	assert.ErrorContains(t, err, `DATABRICKS_CLI_PROGRESS_FORMAT: invalid progress format "foo"`)
+}
+
+func TestInvalidProgressFormatFromEnvironmentWithFlag(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetContext(env.Set(context.Background(), envProgressFormat, "foo"))
+	logFlags := initLogFlags(cmd)
+	f := initProgressLoggerFlag(cmd, logFlags)
+	err := cmd.PersistentFlags().Set("progress-format", "json")
+	require.NoError(t, err)
+
+	// The flag takes precedence over the invalid value in the environment.
+	ctx, err := f.initializeContext(context.Background())
+	require.NoError(t, err)
+	logger, ok := cmdio.FromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, flags.ModeJson, logger.Mode)
+}
+
+func TestProgressLevelFlag(t *testing.T) {
+	plt, _, _, _ := initializeProgressLoggerTest(t)
+	err := plt.Command.ParseFlags([]string{"--progress-level", "WARN"})
+	require.NoError(t, err)
+
+	ctx, err := plt.progressLoggerFlag.initializeContext(context.Background())
+	require.NoError(t, err)
+	logger, ok := cmdio.FromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, flags.LogLevelWarn, logger.Level)
+}
+
+func TestInvalidProgressLevelFlag(t *testing.T) {
+	plt, _, _, _ := initializeProgressLoggerTest(t)
+	err := plt.Command.ParseFlags([]string{"--progress-level", "loud"})
+	assert.ErrorContains(t, err, "must be one of debug, info, warn, error")
+}
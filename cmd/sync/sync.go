@@ -133,6 +133,8 @@ func New() *cobra.Command {
 			outputFunc = textOutput
 		case flags.OutputJSON:
 			outputFunc = jsonOutput
+		default:
+			return fmt.Errorf("unsupported output type: %s", f.output)
 		}
 
 		var wg stdsync.WaitGroup
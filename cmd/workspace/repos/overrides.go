@@ -8,6 +8,7 @@ import (
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/cli/libs/git"
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/spf13/cobra"
@@ -44,7 +45,7 @@ func createOverride(createCmd *cobra.Command, createReq *workspace.CreateRepo) {
 			if len(args) > 1 {
 				createReq.Provider = args[1]
 			} else {
-				createReq.Provider = DetectProvider(createReq.Url)
+				createReq.Provider = git.DetectProvider(createReq.Url)
 				if createReq.Provider == "" {
 					return fmt.Errorf(
 						"could not detect provider from URL %q; please specify", createReq.Url)
@@ -1,16 +1,217 @@
 package tokens
 
 import (
+	"cmp"
+	"fmt"
+	"math"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/service/settings"
 	"github.com/spf13/cobra"
 )
 
 func listOverride(listCmd *cobra.Command) {
 	listCmd.Annotations["headerTemplate"] = cmdio.Heredoc(`
-	{{header "ID"}}	{{header "Expiry time"}}	{{header "Comment"}}`)
+	{{header "ID"}}	{{rjust 19 "Expiry time" | header}}{{if column_nonempty "Comment"}}	{{header "Comment"}}{{end}}`)
 	listCmd.Annotations["template"] = cmdio.Heredoc(`
-	{{range .}}{{.TokenId|green}}	{{cyan "%d" .ExpiryTime}}	{{.Comment|cyan}}
+	{{range .}}{{.TokenId|green}}	{{$expiry := rjust 19 (timestamp_ms .ExpiryTime)}}{{expiry_color .ExpiryTime (red "%s" $expiry) (yellow "%s" $expiry) (green "%s" $expiry)}}{{if column_nonempty "Comment"}}	{{cyan "%s" (truncate 40 .Comment)}}{{end}}
 	{{end}}`)
+	root.RegisterTemplateFuncs(listCmd, templateFuncs)
+
+	var filter string
+	var limit int
+	var sortBy string
+	var reverse bool
+	var asCSV bool
+	listCmd.Flags().StringVar(&filter, "filter", "", `Only list tokens with a comment that contains this substring (case-insensitive).`)
+	listCmd.Flags().IntVar(&limit, "limit", 0, `Maximum number of tokens to list. If not set, all tokens are listed.`)
+	listCmd.Flags().StringVar(&sortBy, "sort", sortNone, `Column to sort tokens by. Supported values: id, expiry, comment, none. By default, tokens are rendered as they are fetched; sorting requires all tokens to be fetched before any are rendered.`)
+	listCmd.Flags().BoolVar(&reverse, "reverse", false, `Sort tokens in descending order.`)
+	listCmd.Flags().BoolVar(&asCSV, "csv", false, `Render the tokens as CSV. Cannot be used with --output json.`)
+
+	listCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		if limit < 0 {
+			return fmt.Errorf("invalid limit %d: must not be negative", limit)
+		}
+		if asCSV && cmdio.OutputFormat(ctx) == flags.OutputJSON {
+			return fmt.Errorf("--csv cannot be used with --output json")
+		}
+
+		var compare func(a, b settings.PublicTokenInfo) int
+		if sortBy != sortNone {
+			var err error
+			compare, err = cmdio.SortKeyCompare(sortKeys, sortBy, reverse)
+			if err != nil {
+				return err
+			}
+		}
+
+		w := root.WorkspaceClient(ctx)
+		it := w.Tokens.List(ctx)
+		if filter != "" {
+			it = cmdio.FilterIterator(it, func(t settings.PublicTokenInfo) bool {
+				return containsFold(t.Comment, filter)
+			})
+		}
+		if compare != nil {
+			// Sorting requires all results to be fetched before rendering.
+			sorted, err := cmdio.SortIterator(ctx, it, compare)
+			if err != nil {
+				return err
+			}
+			it = sorted
+		}
+		if limit > 0 {
+			it = cmdio.LimitIterator(it, limit)
+		}
+		if asCSV {
+			return cmdio.RenderIteratorAsCSV(ctx, it, csvColumns)
+		}
+		return cmdio.RenderIterator(ctx, it)
+	}
+}
+
+// templateFuncs are the functions for the templates of the tokens commands,
+// in addition to the functions that are available to all templates.
+var templateFuncs = template.FuncMap{
+	"until":        until,
+	"expiry_color": expiryColor,
+	"timestamp_ms": func(ms int64) string {
+		// Timestamps that are not set (or negative) indicate no expiry.
+		if ms <= 0 {
+			return "never"
+		}
+		return time.UnixMilli(ms).Local().Format("2006-01-02 15:04:05")
+	},
+}
+
+// now returns the current time. It is a variable so tests can control the clock.
+var now = time.Now
+
+// until renders the time until the specified epoch milliseconds relative to now,
+// for example "in 3 days" or "expired 2h ago".
+// Timestamps that are not set (or negative) indicate no expiry.
+func until(ms int64) string {
+	if ms <= 0 {
+		return "never"
+	}
+	d := time.UnixMilli(ms).Sub(now())
+	if d < 0 {
+		return fmt.Sprintf("expired %s ago", humanDuration(-d))
+	}
+	return fmt.Sprintf("in %s", humanDuration(d))
+}
+
+// expiryColor returns one of the specified strings for an expiry time in epoch
+// milliseconds: red if it expires within 7 days, yellow if it expires within
+// 30 days, and green otherwise. Templates pass the same text in each color,
+// such that it is colored according to the color mode of the output.
+func expiryColor(ms int64, red, yellow, green string) string {
+	// Timestamps that are not set (or negative) indicate no expiry.
+	if ms <= 0 {
+		return green
+	}
+
+	d := time.UnixMilli(ms).Sub(now())
+	switch {
+	case d < 7*24*time.Hour:
+		return red
+	case d < 30*24*time.Hour:
+		return yellow
+	default:
+		return green
+	}
+}
+
+// humanDuration renders the duration using its largest unit.
+func humanDuration(d time.Duration) string {
+	switch {
+	case d >= 48*time.Hour:
+		return fmt.Sprintf("%d days", d/(24*time.Hour))
+	case d >= 24*time.Hour:
+		return "1 day"
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return fmt.Sprintf("%ds", d/time.Second)
+	}
+}
+
+// csvColumns are the columns for CSV output of the list command.
+var csvColumns = []cmdio.Column[settings.PublicTokenInfo]{
+	{
+		Header: "ID",
+		Value: func(t settings.PublicTokenInfo) string {
+			return t.TokenId
+		},
+	},
+	{
+		Header: "ExpiryTime",
+		Value: func(t settings.PublicTokenInfo) string {
+			// Timestamps that are not set (or negative) indicate no expiry.
+			if t.ExpiryTime <= 0 {
+				return ""
+			}
+			return time.UnixMilli(t.ExpiryTime).UTC().Format(time.RFC3339)
+		},
+	},
+	{
+		Header: "Comment",
+		Value: func(t settings.PublicTokenInfo) string {
+			return t.Comment
+		},
+	},
+}
+
+// sortNone disables sorting, such that tokens are rendered in the order
+// the API returns them, as they are fetched.
+const sortNone = "none"
+
+// sortKeys are the columns that the list command can sort by.
+var sortKeys = []cmdio.SortKey[settings.PublicTokenInfo]{
+	{
+		Name: "id",
+		Compare: func(a, b settings.PublicTokenInfo) int {
+			return cmp.Compare(a.TokenId, b.TokenId)
+		},
+	},
+	{
+		Name:    "expiry",
+		Compare: compareExpiryTime,
+	},
+	{
+		Name: "comment",
+		// Comments are free-form text; sort them case-insensitively.
+		Compare: func(a, b settings.PublicTokenInfo) int {
+			return cmp.Compare(strings.ToLower(a.Comment), strings.ToLower(b.Comment))
+		},
+	},
+}
+
+// compareExpiryTime orders tokens by expiry time (soonest first).
+// Tokens that never expire are ordered last.
+func compareExpiryTime(a, b settings.PublicTokenInfo) int {
+	return cmp.Compare(expiryTime(a), expiryTime(b))
+}
+
+// containsFold reports whether substr is within s, ignoring case.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func expiryTime(t settings.PublicTokenInfo) int64 {
+	if t.ExpiryTime <= 0 {
+		return math.MaxInt64
+	}
+	return t.ExpiryTime
 }
 
 func init() {
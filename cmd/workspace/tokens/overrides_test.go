@@ -0,0 +1,388 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type sliceIterator struct {
+	items []settings.PublicTokenInfo
+}
+
+func (s *sliceIterator) HasNext(_ context.Context) bool {
+	return len(s.items) > 0
+}
+
+func (s *sliceIterator) Next(_ context.Context) (settings.PublicTokenInfo, error) {
+	v := s.items[0]
+	s.items = s.items[1:]
+	return v, nil
+}
+
+func runList(t *testing.T, output flags.Output, tokens ...settings.PublicTokenInfo) string {
+	return runListWithArgs(t, output, nil, tokens...)
+}
+
+func runListWithArgs(t *testing.T, output flags.Output, args []string, tokens ...settings.PublicTokenInfo) string {
+	m := mocks.NewMockWorkspaceClient(t)
+	m.GetMockTokensAPI().EXPECT().List(mock.Anything).Return(&sliceIterator{
+		items: tokens,
+	})
+
+	cmd := newList()
+	err := cmd.ParseFlags(args)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	cmdIO := cmdio.NewIO(output, nil, &out, &out, cmd.Annotations["headerTemplate"], cmd.Annotations["template"])
+	cmdIO.SetTemplateFuncs(templateFuncs)
+	ctx := cmdio.InContext(context.Background(), cmdIO)
+	ctx = root.SetWorkspaceClient(ctx, m.WorkspaceClient)
+	cmd.SetContext(ctx)
+
+	err = cmd.RunE(cmd, nil)
+	require.NoError(t, err)
+	return out.String()
+}
+
+var testTokens = []settings.PublicTokenInfo{
+	{TokenId: "abc", ExpiryTime: 1700000000000, Comment: "ci"},
+	{TokenId: "def", ExpiryTime: -1, Comment: "local"},
+}
+
+func TestListRendersTable(t *testing.T) {
+	local := time.Local
+	time.Local = time.UTC
+	t.Cleanup(func() { time.Local = local })
+
+	out := runList(t, flags.OutputText, testTokens...)
+	assert.Equal(t, ""+
+		"ID           Expiry time  Comment\n"+
+		"abc  2023-11-14 22:13:20  ci\n"+
+		"def                never  local\n", out)
+}
+
+func TestListRendersJson(t *testing.T) {
+	out := runList(t, flags.OutputJSON, testTokens...)
+	assert.JSONEq(t, `[
+		{"token_id": "abc", "expiry_time": 1700000000000, "comment": "ci"},
+		{"token_id": "def", "expiry_time": -1, "comment": "local"}
+	]`, out)
+}
+
+func TestListSortsByExpiryTime(t *testing.T) {
+	out := runListWithArgs(t, flags.OutputJSON, []string{"--sort", "expiry"},
+		settings.PublicTokenInfo{TokenId: "never", ExpiryTime: -1},
+		settings.PublicTokenInfo{TokenId: "later", ExpiryTime: 1800000000000},
+		settings.PublicTokenInfo{TokenId: "unset"},
+		settings.PublicTokenInfo{TokenId: "soon", ExpiryTime: 1700000000000},
+	)
+	assert.JSONEq(t, `[
+		{"token_id": "soon", "expiry_time": 1700000000000},
+		{"token_id": "later", "expiry_time": 1800000000000},
+		{"token_id": "never", "expiry_time": -1},
+		{"token_id": "unset"}
+	]`, out)
+}
+
+func TestListSortByColumn(t *testing.T) {
+	tokens := []settings.PublicTokenInfo{
+		{TokenId: "b", ExpiryTime: 1800000000000, Comment: "zeta"},
+		{TokenId: "c", ExpiryTime: -1, Comment: "alpha"},
+		{TokenId: "a", ExpiryTime: 900000000000, Comment: "Mu"},
+	}
+
+	ids := func(args ...string) string {
+		out := runListWithArgs(t, flags.OutputText, append([]string{"--csv"}, args...), tokens...)
+		records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+		require.NoError(t, err)
+		var ids []string
+		for _, record := range records[1:] {
+			ids = append(ids, record[0])
+		}
+		return strings.Join(ids, ",")
+	}
+
+	assert.Equal(t, "a,b,c", ids("--sort", "id"))
+	assert.Equal(t, "c,b,a", ids("--sort", "id", "--reverse"))
+	assert.Equal(t, "a,b,c", ids("--sort", "expiry"))
+	assert.Equal(t, "c,b,a", ids("--sort", "EXPIRY", "--reverse"))
+	assert.Equal(t, "c,a,b", ids("--sort", "comment"))
+}
+
+func TestListSortByInvalidColumn(t *testing.T) {
+	cmd := newList()
+	err := cmd.ParseFlags([]string{"--sort", "owner"})
+	require.NoError(t, err)
+	cmd.SetContext(context.Background())
+
+	err = cmd.RunE(cmd, nil)
+	assert.EqualError(t, err, `invalid sort column "owner": must be one of id, expiry, comment`)
+}
+
+func TestListTruncatesLongComments(t *testing.T) {
+	out := runList(t, flags.OutputText, settings.PublicTokenInfo{
+		TokenId:    "abc",
+		ExpiryTime: -1,
+		Comment:    strings.Repeat("x", 50),
+	})
+	assert.Contains(t, out, strings.Repeat("x", 39)+"…\n")
+	assert.NotContains(t, out, strings.Repeat("x", 40))
+}
+
+func TestListFilterByComment(t *testing.T) {
+	tokens := []settings.PublicTokenInfo{
+		{TokenId: "abc", ExpiryTime: -1, Comment: "Deploy from CI"},
+		{TokenId: "def", ExpiryTime: -1, Comment: "local development"},
+	}
+
+	out := runListWithArgs(t, flags.OutputJSON, []string{"--filter", "ci"}, tokens...)
+	assert.JSONEq(t, `[
+		{"token_id": "abc", "expiry_time": -1, "comment": "Deploy from CI"}
+	]`, out)
+
+	out = runListWithArgs(t, flags.OutputJSON, []string{"--filter", "notebook"}, tokens...)
+	assert.JSONEq(t, `[]`, out)
+}
+
+func TestListLimit(t *testing.T) {
+	tokens := []settings.PublicTokenInfo{
+		{TokenId: "abc", ExpiryTime: -1},
+		{TokenId: "def", ExpiryTime: -1},
+		{TokenId: "ghi", ExpiryTime: -1},
+	}
+
+	out := runListWithArgs(t, flags.OutputJSON, []string{"--limit", "2"}, tokens...)
+	assert.JSONEq(t, `[
+		{"token_id": "abc", "expiry_time": -1},
+		{"token_id": "def", "expiry_time": -1}
+	]`, out)
+}
+
+func TestListLimitAppliesAfterSorting(t *testing.T) {
+	tokens := []settings.PublicTokenInfo{
+		{TokenId: "later", ExpiryTime: 1800000000000},
+		{TokenId: "never", ExpiryTime: -1},
+		{TokenId: "soon", ExpiryTime: 1700000000000},
+	}
+
+	out := runListWithArgs(t, flags.OutputJSON, []string{"--sort", "expiry", "--limit", "2"}, tokens...)
+	assert.JSONEq(t, `[
+		{"token_id": "soon", "expiry_time": 1700000000000},
+		{"token_id": "later", "expiry_time": 1800000000000}
+	]`, out)
+}
+
+func TestListNegativeLimit(t *testing.T) {
+	cmd := newList()
+	err := cmd.ParseFlags([]string{"--limit", "-1"})
+	require.NoError(t, err)
+	cmd.SetContext(context.Background())
+
+	err = cmd.RunE(cmd, nil)
+	assert.EqualError(t, err, "invalid limit -1: must not be negative")
+}
+
+// pageIterator yields the items of its pages and records
+// how many pages were fetched, like a paginated API would.
+type pageIterator struct {
+	pages   [][]settings.PublicTokenInfo
+	current []settings.PublicTokenInfo
+	fetched int
+}
+
+func (p *pageIterator) HasNext(_ context.Context) bool {
+	if len(p.current) == 0 && len(p.pages) > 0 {
+		p.current, p.pages = p.pages[0], p.pages[1:]
+		p.fetched++
+	}
+	return len(p.current) > 0
+}
+
+func (p *pageIterator) Next(ctx context.Context) (settings.PublicTokenInfo, error) {
+	p.HasNext(ctx)
+	v := p.current[0]
+	p.current = p.current[1:]
+	return v, nil
+}
+
+func TestListStreamsPagesByDefault(t *testing.T) {
+	it := &pageIterator{
+		pages: [][]settings.PublicTokenInfo{
+			{{TokenId: "abc", ExpiryTime: -1}, {TokenId: "def", ExpiryTime: -1}},
+			{{TokenId: "ghi", ExpiryTime: -1}},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	m.GetMockTokensAPI().EXPECT().List(mock.Anything).Return(it)
+
+	cmd := newList()
+	err := cmd.ParseFlags([]string{"--limit", "2"})
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	cmdIO := cmdio.NewIO(flags.OutputJSON, nil, &out, &out, "", "")
+	ctx := cmdio.InContext(context.Background(), cmdIO)
+	ctx = root.SetWorkspaceClient(ctx, m.WorkspaceClient)
+	cmd.SetContext(ctx)
+
+	err = cmd.RunE(cmd, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"token_id": "abc", "expiry_time": -1},
+		{"token_id": "def", "expiry_time": -1}
+	]`, out.String())
+
+	// The second page is never fetched.
+	assert.Equal(t, 1, it.fetched)
+}
+
+func TestListRendersCSV(t *testing.T) {
+	out := runListWithArgs(t, flags.OutputText, []string{"--csv"},
+		settings.PublicTokenInfo{TokenId: "abc", ExpiryTime: 1700000000000, Comment: "ci, nightly"},
+		settings.PublicTokenInfo{TokenId: "def", ExpiryTime: -1, Comment: "local"},
+	)
+
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"ID", "ExpiryTime", "Comment"},
+		{"abc", "2023-11-14T22:13:20Z", "ci, nightly"},
+		{"def", "", "local"},
+	}, records)
+}
+
+func TestListRejectsCSVWithJsonOutput(t *testing.T) {
+	cmd := newList()
+	err := cmd.ParseFlags([]string{"--csv"})
+	require.NoError(t, err)
+
+	cmdIO := cmdio.NewIO(flags.OutputJSON, nil, &bytes.Buffer{}, &bytes.Buffer{}, "", "")
+	cmd.SetContext(cmdio.InContext(context.Background(), cmdIO))
+
+	// The API is not called.
+	err = cmd.RunE(cmd, nil)
+	assert.EqualError(t, err, "--csv cannot be used with --output json")
+}
+
+func TestListHidesEmptyComments(t *testing.T) {
+	// Empty columns are only hidden if all tokens are fetched before rendering.
+	out := runListWithArgs(t, flags.OutputText, []string{"--sort", "expiry"},
+		settings.PublicTokenInfo{TokenId: "abc", ExpiryTime: -1},
+		settings.PublicTokenInfo{TokenId: "def", ExpiryTime: -1},
+	)
+	assert.Equal(t, ""+
+		"ID           Expiry time\n"+
+		"abc                never\n"+
+		"def                never\n", out)
+}
+
+func TestListWithoutHeader(t *testing.T) {
+	m := mocks.NewMockWorkspaceClient(t)
+	m.GetMockTokensAPI().EXPECT().List(mock.Anything).Return(&sliceIterator{
+		items: testTokens,
+	})
+
+	cmd := newList()
+	var out bytes.Buffer
+	cmdIO := cmdio.NewIO(flags.OutputText, nil, &out, &out, cmd.Annotations["headerTemplate"], cmd.Annotations["template"])
+	cmdIO.SetTemplateFuncs(templateFuncs)
+	cmdIO.SetNoHeader(true)
+	ctx := cmdio.InContext(context.Background(), cmdIO)
+	ctx = root.SetWorkspaceClient(ctx, m.WorkspaceClient)
+	cmd.SetContext(ctx)
+
+	err := cmd.RunE(cmd, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, out.String(), "Expiry time")
+	assert.Contains(t, out.String(), "abc")
+}
+
+func TestListColorsExpiryTime(t *testing.T) {
+	ref := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return ref }
+	t.Cleanup(func() { now = time.Now })
+
+	m := mocks.NewMockWorkspaceClient(t)
+	m.GetMockTokensAPI().EXPECT().List(mock.Anything).Return(&sliceIterator{
+		items: []settings.PublicTokenInfo{{TokenId: "abc", ExpiryTime: ref.Add(time.Hour).UnixMilli()}},
+	})
+
+	cmd := newList()
+	var out bytes.Buffer
+	cmdIO := cmdio.NewIO(flags.OutputText, nil, &out, &out, "", cmd.Annotations["template"])
+	cmdIO.SetColorMode(flags.ColorAlways)
+	cmdIO.SetTemplateFuncs(templateFuncs)
+	ctx := cmdio.InContext(context.Background(), cmdIO)
+	ctx = root.SetWorkspaceClient(ctx, m.WorkspaceClient)
+	cmd.SetContext(ctx)
+
+	err := cmd.RunE(cmd, nil)
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "\x1b[31m")
+}
+
+func TestTimestampMs(t *testing.T) {
+	local := time.Local
+	time.Local = time.UTC
+	t.Cleanup(func() { time.Local = local })
+
+	timestampMs := templateFuncs["timestamp_ms"].(func(int64) string)
+	assert.Equal(t, "2023-11-14 22:13:20", timestampMs(1700000000000))
+	assert.Equal(t, "never", timestampMs(0))
+	assert.Equal(t, "never", timestampMs(-1))
+}
+
+func TestUntil(t *testing.T) {
+	ref := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return ref }
+	t.Cleanup(func() { now = time.Now })
+
+	assert.Equal(t, "never", until(0))
+	assert.Equal(t, "never", until(-1))
+	assert.Equal(t, "in 3 days", until(ref.Add(3*24*time.Hour+5*time.Hour).UnixMilli()))
+	assert.Equal(t, "in 1 day", until(ref.Add(30*time.Hour).UnixMilli()))
+	assert.Equal(t, "in 5h", until(ref.Add(5*time.Hour+10*time.Minute).UnixMilli()))
+	assert.Equal(t, "in 10m", until(ref.Add(10*time.Minute).UnixMilli()))
+	assert.Equal(t, "in 30s", until(ref.Add(30*time.Second).UnixMilli()))
+	assert.Equal(t, "expired 2h ago", until(ref.Add(-2*time.Hour).UnixMilli()))
+	assert.Equal(t, "expired 7 days ago", until(ref.Add(-7*24*time.Hour).UnixMilli()))
+}
+
+func TestExpiryColor(t *testing.T) {
+	ref := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return ref }
+	t.Cleanup(func() { now = time.Now })
+
+	days := func(n int) int64 {
+		return ref.Add(time.Duration(n) * 24 * time.Hour).UnixMilli()
+	}
+
+	for _, c := range []struct {
+		ms       int64
+		expected string
+	}{
+		{-1, "green"},
+		{0, "green"},
+		{days(-1), "red"},
+		{days(3), "red"},
+		{days(10), "yellow"},
+		{days(60), "green"},
+	} {
+		assert.Equal(t, c.expected, expiryColor(c.ms, "red", "yellow", "green"))
+	}
+}
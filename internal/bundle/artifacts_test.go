@@ -42,7 +42,7 @@ func TestAccUploadArtifactFileToCorrectRemotePath(t *testing.T) {
 				Target: "whatever",
 			},
 			Workspace: config.Workspace{
-				ArtifactPath: wsDir,
+				ArtifactPath: config.PathLike(wsDir),
 			},
 			Artifacts: config.Artifacts{
 				"test": &config.Artifact{
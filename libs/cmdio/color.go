@@ -0,0 +1,47 @@
+package cmdio
+
+import (
+	"context"
+
+	"github.com/databricks/cli/libs/env"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/fatih/color"
+)
+
+// IsColorEnabled returns true if output should be colored.
+//
+// It is determined by the color mode of the cmdIO in the context.
+// In auto mode, color is enabled if stdout is a terminal and
+// the NO_COLOR environment variable is not set.
+// Without a cmdIO in the context, the global [color.NoColor] setting applies.
+func IsColorEnabled(ctx context.Context) bool {
+	c, ok := ctx.Value(cmdIOKey).(*cmdIO)
+	if !ok {
+		return !color.NoColor
+	}
+	switch c.colorMode {
+	case flags.ColorAlways:
+		return true
+	case flags.ColorNever:
+		return false
+	}
+	return env.Get(ctx, "NO_COLOR") == "" && c.IsTTY()
+}
+
+// newColor returns a color with the specified attributes that ignores
+// the global [color.NoColor] setting in favor of the enabled argument.
+func newColor(enabled bool, value ...color.Attribute) *color.Color {
+	c := color.New(value...)
+	if enabled {
+		c.EnableColor()
+	} else {
+		c.DisableColor()
+	}
+	return c
+}
+
+// Sprintf formats according to a format specifier and colors
+// the result with the specified attributes if color is enabled.
+func Sprintf(ctx context.Context, value color.Attribute, format string, a ...any) string {
+	return newColor(IsColorEnabled(ctx), value).Sprintf(format, a...)
+}
@@ -0,0 +1,46 @@
+package cmdio
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/libs/env"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func newColorTestContext(mode flags.ColorMode) context.Context {
+	cmdIO := NewIO(flags.OutputText, nil, &bytes.Buffer{}, &bytes.Buffer{}, "", "")
+	cmdIO.SetColorMode(mode)
+	return InContext(context.Background(), cmdIO)
+}
+
+func TestIsColorEnabled(t *testing.T) {
+	assert.True(t, IsColorEnabled(newColorTestContext(flags.ColorAlways)))
+	assert.False(t, IsColorEnabled(newColorTestContext(flags.ColorNever)))
+
+	// Auto mode only colors output written to a terminal.
+	assert.False(t, IsColorEnabled(newColorTestContext(flags.ColorAuto)))
+}
+
+func TestIsColorEnabledAlwaysIgnoresNoColor(t *testing.T) {
+	ctx := env.Set(newColorTestContext(flags.ColorAlways), "NO_COLOR", "1")
+	assert.True(t, IsColorEnabled(ctx))
+}
+
+func TestIsColorEnabledWithoutCmdIO(t *testing.T) {
+	noColor := color.NoColor
+	t.Cleanup(func() { color.NoColor = noColor })
+
+	color.NoColor = true
+	assert.False(t, IsColorEnabled(context.Background()))
+	color.NoColor = false
+	assert.True(t, IsColorEnabled(context.Background()))
+}
+
+func TestSprintf(t *testing.T) {
+	assert.Equal(t, "\x1b[32mhello world\x1b[0m", Sprintf(newColorTestContext(flags.ColorAlways), color.FgGreen, "hello %s", "world"))
+	assert.Equal(t, "hello world", Sprintf(newColorTestContext(flags.ColorNever), color.FgGreen, "hello %s", "world"))
+}
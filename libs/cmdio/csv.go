@@ -0,0 +1,51 @@
+package cmdio
+
+import (
+	"context"
+	"encoding/csv"
+
+	"github.com/databricks/databricks-sdk-go/listing"
+)
+
+// Column describes a column of tabular output, such as CSV.
+type Column[T any] struct {
+	// Header is the name of the column in the header row.
+	Header string
+
+	// Value returns the value of the column for an item.
+	Value func(T) string
+}
+
+// RenderIteratorAsCSV renders the items of the iterator as CSV with the specified columns.
+// Commands that support CSV output opt into it with a flag of their own, because CSV
+// cannot be rendered without a column description. They should reject this flag if
+// JSON output is selected (see [OutputFormat]).
+func RenderIteratorAsCSV[T any](ctx context.Context, i listing.Iterator[T], columns []Column[T]) error {
+	c := fromContext(ctx)
+	w := csv.NewWriter(c.out)
+	row := make([]string, len(columns))
+	for j, col := range columns {
+		row[j] = col.Header
+	}
+	err := w.Write(row)
+	if err != nil {
+		return err
+	}
+
+	for i.HasNext(ctx) {
+		item, err := i.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for j, col := range columns {
+			row[j] = col.Value(item)
+		}
+		err = w.Write(row)
+		if err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
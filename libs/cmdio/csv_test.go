@@ -0,0 +1,52 @@
+package cmdio
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strconv"
+	"testing"
+
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var workspaceColumns = []Column[*provisioning.Workspace]{
+	{
+		Header: "ID",
+		Value: func(w *provisioning.Workspace) string {
+			return strconv.FormatInt(w.WorkspaceId, 10)
+		},
+	},
+	{
+		Header: "Name",
+		Value: func(w *provisioning.Workspace) string {
+			return w.WorkspaceName
+		},
+	},
+}
+
+func TestRenderIteratorAsCSV(t *testing.T) {
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(flags.OutputText, nil, output, output, "", "")
+	ctx := InContext(context.Background(), cmdIO)
+
+	it := &sliceIterator[*provisioning.Workspace]{items: []*provisioning.Workspace{
+		{WorkspaceId: 123, WorkspaceName: "abc"},
+		{WorkspaceId: 456, WorkspaceName: `with "quotes", and commas`},
+	}}
+	err := RenderIteratorAsCSV(ctx, it, workspaceColumns)
+	require.NoError(t, err)
+	assert.Equal(t, "ID,Name\n123,abc\n456,\"with \"\"quotes\"\", and commas\"\n", output.String())
+
+	// The output can be parsed back.
+	records, err := csv.NewReader(output).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"ID", "Name"},
+		{"123", "abc"},
+		{"456", `with "quotes", and commas`},
+	}, records)
+}
@@ -1,6 +1,10 @@
 package cmdio
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/databricks/cli/libs/flags"
+)
 
 type ErrorEvent struct {
 	Error string `json:"error"`
@@ -13,3 +17,11 @@ func (event *ErrorEvent) String() string {
 func (event *ErrorEvent) IsInplaceSupported() bool {
 	return false
 }
+
+func (event *ErrorEvent) Type() string {
+	return "error"
+}
+
+func (event *ErrorEvent) Level() flags.LogLevel {
+	return flags.LogLevelError
+}
@@ -6,4 +6,8 @@ type Event interface {
 
 	// true if event supports inplace logging, return false otherwise
 	IsInplaceSupported() bool
+
+	// stable identifier for the category of the event. It is included as the
+	// "type" field when the event is logged as json
+	Type() string
 }
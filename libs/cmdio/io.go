@@ -7,6 +7,7 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -24,6 +25,9 @@ type cmdIO struct {
 	// e.g. if stdout is a terminal
 	interactive    bool
 	outputFormat   flags.Output
+	colorMode      flags.ColorMode
+	templateFuncs  template.FuncMap
+	noHeader       bool
 	headerTemplate string
 	template       string
 	in             io.Reader
@@ -40,6 +44,7 @@ func NewIO(outputFormat flags.Output, in io.Reader, out io.Writer, err io.Writer
 	return &cmdIO{
 		interactive:    !dumb,
 		outputFormat:   outputFormat,
+		colorMode:      flags.NewColorMode(),
 		headerTemplate: headerTemplate,
 		template:       template,
 		in:             in,
@@ -48,6 +53,22 @@ func NewIO(outputFormat flags.Output, in io.Reader, out io.Writer, err io.Writer
 	}
 }
 
+// SetColorMode configures whether rendered output is colored.
+func (c *cmdIO) SetColorMode(mode flags.ColorMode) {
+	c.colorMode = mode
+}
+
+// SetTemplateFuncs configures additional functions for use in templates.
+// They take precedence over the functions defined by this package.
+func (c *cmdIO) SetTemplateFuncs(funcs template.FuncMap) {
+	c.templateFuncs = funcs
+}
+
+// SetNoHeader configures whether header templates are omitted from text output.
+func (c *cmdIO) SetNoHeader(noHeader bool) {
+	c.noHeader = noHeader
+}
+
 func IsInteractive(ctx context.Context) bool {
 	c := fromContext(ctx)
 	return c.interactive
@@ -63,6 +84,12 @@ func IsTTY(w any) bool {
 	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
 }
 
+// OutputFormat returns the configured output format.
+func OutputFormat(ctx context.Context) flags.Output {
+	c := fromContext(ctx)
+	return c.outputFormat
+}
+
 // IsInTTY detects if the input reader is a terminal.
 func IsInTTY(ctx context.Context) bool {
 	c := fromContext(ctx)
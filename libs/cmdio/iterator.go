@@ -0,0 +1,118 @@
+package cmdio
+
+import (
+	"context"
+	"slices"
+
+	"github.com/databricks/databricks-sdk-go/listing"
+)
+
+// sliceIterator implements [listing.Iterator] for a slice of items.
+type sliceIterator[T any] struct {
+	items []T
+}
+
+func (s *sliceIterator[T]) HasNext(_ context.Context) bool {
+	return len(s.items) > 0
+}
+
+func (s *sliceIterator[T]) Next(_ context.Context) (T, error) {
+	var v T
+	if len(s.items) == 0 {
+		return v, listing.ErrNoMoreItems
+	}
+	v, s.items = s.items[0], s.items[1:]
+	return v, nil
+}
+
+// SortIterator consumes the specified iterator and returns an iterator
+// that yields its items in the order defined by the cmp function.
+//
+// It can be used to sort the output of list commands before rendering.
+func SortIterator[T any](ctx context.Context, i listing.Iterator[T], cmp func(a, b T) int) (listing.Iterator[T], error) {
+	items, err := listing.ToSlice(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(items, cmp)
+	return &sliceIterator[T]{items: items}, nil
+}
+
+// filterIterator implements [listing.Iterator] and yields only
+// the items of the underlying iterator for which keep returns true.
+type filterIterator[T any] struct {
+	it   listing.Iterator[T]
+	keep func(T) bool
+
+	// Next item to yield, if any.
+	next *T
+	err  error
+}
+
+func (f *filterIterator[T]) HasNext(ctx context.Context) bool {
+	for f.next == nil && f.err == nil && f.it.HasNext(ctx) {
+		v, err := f.it.Next(ctx)
+		if err != nil {
+			f.err = err
+			break
+		}
+		if f.keep(v) {
+			f.next = &v
+		}
+	}
+	return f.next != nil || f.err != nil
+}
+
+func (f *filterIterator[T]) Next(ctx context.Context) (T, error) {
+	var v T
+	if !f.HasNext(ctx) {
+		return v, listing.ErrNoMoreItems
+	}
+	if f.err != nil {
+		err := f.err
+		f.err = nil
+		return v, err
+	}
+	v, f.next = *f.next, nil
+	return v, nil
+}
+
+// FilterIterator returns an iterator that yields only the items
+// of the specified iterator for which the keep function returns true.
+//
+// It can be used to filter the output of list commands before rendering.
+func FilterIterator[T any](i listing.Iterator[T], keep func(T) bool) listing.Iterator[T] {
+	return &filterIterator[T]{it: i, keep: keep}
+}
+
+// limitIterator implements [listing.Iterator] and yields
+// at most limit items of the underlying iterator.
+type limitIterator[T any] struct {
+	it    listing.Iterator[T]
+	limit int
+	count int
+}
+
+func (l *limitIterator[T]) HasNext(ctx context.Context) bool {
+	return l.count < l.limit && l.it.HasNext(ctx)
+}
+
+func (l *limitIterator[T]) Next(ctx context.Context) (T, error) {
+	var v T
+	if l.count >= l.limit {
+		return v, listing.ErrNoMoreItems
+	}
+	v, err := l.it.Next(ctx)
+	if err != nil {
+		return v, err
+	}
+	l.count++
+	return v, nil
+}
+
+// LimitIterator returns an iterator that yields at most limit items
+// of the specified iterator. Because the underlying iterator is consumed
+// lazily, pages beyond the limit are never fetched.
+func LimitIterator[T any](i listing.Iterator[T], limit int) listing.Iterator[T] {
+	return &limitIterator[T]{it: i, limit: limit}
+}
@@ -0,0 +1,122 @@
+package cmdio
+
+import (
+	"cmp"
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortIterator(t *testing.T) {
+	ctx := context.Background()
+	in := &sliceIterator[int]{items: []int{3, 1, 2}}
+
+	out, err := SortIterator(ctx, in, cmp.Compare[int])
+	require.NoError(t, err)
+
+	items, err := listing.ToSlice(ctx, out)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+}
+
+func TestSortIteratorIsStable(t *testing.T) {
+	ctx := context.Background()
+	in := &sliceIterator[string]{items: []string{"bb", "a", "cc", "b"}}
+
+	out, err := SortIterator(ctx, in, func(a, b string) int {
+		return cmp.Compare(len(a), len(b))
+	})
+	require.NoError(t, err)
+
+	items, err := listing.ToSlice(ctx, out)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "bb", "cc"}, items)
+}
+
+func TestSliceIteratorNextWhenEmpty(t *testing.T) {
+	it := &sliceIterator[int]{}
+	assert.False(t, it.HasNext(context.Background()))
+	_, err := it.Next(context.Background())
+	assert.Error(t, err)
+}
+
+func TestFilterIterator(t *testing.T) {
+	ctx := context.Background()
+	in := &sliceIterator[int]{items: []int{1, 2, 3, 4, 5}}
+
+	out := FilterIterator(in, func(v int) bool {
+		return v%2 == 1
+	})
+
+	items, err := listing.ToSlice(ctx, out)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 3, 5}, items)
+}
+
+func TestFilterIteratorNoMatch(t *testing.T) {
+	ctx := context.Background()
+	in := &sliceIterator[int]{items: []int{1, 2, 3}}
+
+	out := FilterIterator(in, func(v int) bool {
+		return false
+	})
+
+	assert.False(t, out.HasNext(ctx))
+	_, err := out.Next(ctx)
+	assert.Error(t, err)
+}
+
+// newPaginatedIterator returns an iterator over the numbers [0, n) that
+// fetches pages of the specified size and records the number of pages fetched.
+func newPaginatedIterator(n, size int, pages *int) listing.Iterator[int] {
+	return listing.NewIterator(
+		&struct{ offset int }{},
+		func(_ context.Context, req struct{ offset int }) ([]int, error) {
+			*pages++
+			var page []int
+			for i := req.offset; i < n && i < req.offset+size; i++ {
+				page = append(page, i)
+			}
+			return page, nil
+		},
+		func(page []int) []int {
+			return page
+		},
+		func(page []int) *struct{ offset int } {
+			if len(page) < size {
+				return nil
+			}
+			return &struct{ offset int }{offset: page[len(page)-1] + 1}
+		},
+	)
+}
+
+func TestLimitIterator(t *testing.T) {
+	ctx := context.Background()
+	pages := 0
+	out := LimitIterator(newPaginatedIterator(10, 2, &pages), 3)
+
+	items, err := listing.ToSlice(ctx, out)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, items)
+
+	// Pages beyond the limit are not fetched.
+	assert.Equal(t, 2, pages)
+
+	_, err = out.Next(ctx)
+	assert.ErrorIs(t, err, listing.ErrNoMoreItems)
+}
+
+func TestLimitIteratorAboveTotal(t *testing.T) {
+	ctx := context.Background()
+	pages := 0
+	out := LimitIterator(newPaginatedIterator(5, 2, &pages), 100)
+
+	items, err := listing.ToSlice(ctx, out)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, items)
+	assert.Equal(t, 3, pages)
+}
@@ -2,22 +2,30 @@ package cmdio
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/databricks/cli/libs/flags"
 	"github.com/manifoldco/promptui"
+	"golang.org/x/term"
 )
 
 // This is the interface for all io interactions with a user
 type Logger struct {
-	// Mode for the logger. One of (append, inplace, json).
+	// Mode for the logger. One of (append, inplace, json, ndjson, none).
+	// The auto mode is resolved by [NewLogger].
 	Mode flags.ProgressLogFormat
 
+	// Minimum level of the events to emit. Events are logged at the info
+	// level unless they implement a Level method. The zero value emits all events.
+	Level flags.LogLevel
+
 	// Input stream (eg. stdin). Answers to questions prompted using the Ask() method
 	// are read from here
 	Reader bufio.Reader
@@ -28,23 +36,51 @@ type Logger struct {
 	// If true, indicates no events have been printed by the logger yet. Used
 	// by inplace logging for formatting
 	isFirstEvent bool
+
+	// Estimates the time remaining for progress events.
+	eta etaEstimator
+
+	// File that Reader reads from, if any. Used to put a terminal in raw mode
+	// to read single keystrokes.
+	in *os.File
 }
 
 func NewLogger(mode flags.ProgressLogFormat) *Logger {
+	return newLogger(mode, os.Stderr)
+}
+
+func newLogger(mode flags.ProgressLogFormat, w io.Writer) *Logger {
 	return &Logger{
-		Mode:         mode,
-		Writer:       os.Stderr,
+		Mode:         resolveMode(mode, w),
+		Writer:       w,
 		Reader:       *bufio.NewReader(os.Stdin),
 		isFirstEvent: true,
+		in:           os.Stdin,
 	}
 }
 
+// isTerminal is a variable so that tests can simulate a terminal.
+var isTerminal = IsTTY
+
+// resolveMode returns the concrete mode for the auto mode:
+// inplace if the writer is a terminal and append otherwise.
+func resolveMode(mode flags.ProgressLogFormat, w io.Writer) flags.ProgressLogFormat {
+	if mode != flags.ModeAuto {
+		return mode
+	}
+	if isTerminal(w) {
+		return flags.ModeInplace
+	}
+	return flags.ModeAppend
+}
+
 func Default() *Logger {
 	return &Logger{
 		Mode:         flags.ModeAppend,
 		Writer:       os.Stderr,
 		Reader:       *bufio.NewReader(os.Stdin),
 		isFirstEvent: true,
+		in:           os.Stdin,
 	}
 }
 
@@ -84,6 +120,14 @@ func Ask(ctx context.Context, question, defaultVal string) (string, error) {
 	return logger.Ask(question, defaultVal)
 }
 
+func AskChar(ctx context.Context, question string, valid []rune) (rune, error) {
+	logger, ok := FromContext(ctx)
+	if !ok {
+		logger = Default()
+	}
+	return logger.AskChar(question, valid)
+}
+
 func AskYesOrNo(ctx context.Context, question string) (bool, error) {
 	logger, ok := FromContext(ctx)
 	if !ok {
@@ -123,8 +167,8 @@ func splitAtLastNewLine(s string) (string, string) {
 }
 
 func (l *Logger) AskSelect(question string, choices []string) (string, error) {
-	if l.Mode == flags.ModeJson {
-		return "", fmt.Errorf("question prompts are not supported in json mode")
+	if l.Mode == flags.ModeJson || l.Mode == flags.ModeNdjson {
+		return "", fmt.Errorf("question prompts are not supported in %s mode", l.Mode)
 	}
 
 	// Promptui does not support multiline prompts. So we split the question.
@@ -152,8 +196,8 @@ func (l *Logger) AskSelect(question string, choices []string) (string, error) {
 }
 
 func (l *Logger) Ask(question string, defaultVal string) (string, error) {
-	if l.Mode == flags.ModeJson {
-		return "", fmt.Errorf("question prompts are not supported in json mode")
+	if l.Mode == flags.ModeJson || l.Mode == flags.ModeNdjson {
+		return "", fmt.Errorf("question prompts are not supported in %s mode", l.Mode)
 	}
 
 	// Add default value to question prompt.
@@ -182,8 +226,118 @@ func (l *Logger) Ask(question string, defaultVal string) (string, error) {
 	return ans, nil
 }
 
+// AskChar prompts the user with a question and reads a single keystroke
+// without requiring the user to press Enter. If valid is not empty,
+// the keystroke must be one of the valid runes.
+//
+// If the input is a terminal, it is put in raw mode while reading the keystroke.
+// Otherwise, a single byte is read from the input and the remainder of the
+// line is discarded, such that the next prompt starts reading at the next line.
+func (l *Logger) AskChar(question string, valid []rune) (rune, error) {
+	if l.Mode == flags.ModeJson || l.Mode == flags.ModeNdjson {
+		return 0, fmt.Errorf("question prompts are not supported in %s mode", l.Mode)
+	}
+
+	// print prompt
+	_, err := l.Writer.Write([]byte(question + ` `))
+	if err != nil {
+		return 0, err
+	}
+
+	r, err := l.readChar()
+	if err != nil {
+		return 0, err
+	}
+
+	// Echo the keystroke since raw mode disables echoing.
+	_, err = l.Writer.Write([]byte(string(r) + "\n"))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(valid) > 0 && !slices.Contains(valid, r) {
+		names := make([]string, len(valid))
+		for i, v := range valid {
+			names[i] = string(v)
+		}
+		return 0, fmt.Errorf("invalid input %q: expected one of %s", r, strings.Join(names, ", "))
+	}
+	return r, nil
+}
+
+func (l *Logger) readChar() (rune, error) {
+	if l.in == nil || !IsTTY(l.in) {
+		b, err := l.Reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if b != '\n' {
+			_, err = l.Reader.ReadString('\n')
+			if err != nil && err != io.EOF {
+				return 0, err
+			}
+		}
+		return rune(b), nil
+	}
+
+	fd := int(l.in.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return 0, err
+	}
+	defer term.Restore(fd, state)
+
+	r, _, err := l.Reader.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+	return r, nil
+}
+
+// marshalEvent serializes the event as a JSON object whose first field is
+// the event type, so consumers can route events by category.
+func marshalEvent(event Event) ([]byte, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := json.Marshal(event.Type())
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) < 2 || payload[0] != '{' {
+		return nil, fmt.Errorf("event of type %s does not serialize to a json object", event.Type())
+	}
+	var buf bytes.Buffer
+	buf.WriteString(`{"type":`)
+	buf.Write(typ)
+	if rest := payload[1:]; rest[0] != '}' {
+		buf.WriteByte(',')
+		buf.Write(rest)
+	} else {
+		buf.WriteByte('}')
+	}
+	return buf.Bytes(), nil
+}
+
 func (l *Logger) writeJson(event Event) {
-	b, err := json.MarshalIndent(event, "", "  ")
+	payload, err := marshalEvent(event)
+	if err != nil {
+		// we panic because there we cannot catch this in jobs.RunNowAndWait
+		panic(err)
+	}
+	var b bytes.Buffer
+	err = json.Indent(&b, payload, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	l.Writer.Write(b.Bytes())
+	l.Writer.Write([]byte("\n"))
+}
+
+// writeNdjson writes the event as a single line of JSON.
+func (l *Logger) writeNdjson(event Event) {
+	b, err := marshalEvent(event)
 	if err != nil {
 		// we panic because there we cannot catch this in jobs.RunNowAndWait
 		panic(err)
@@ -214,7 +368,28 @@ func (l *Logger) writeInplace(event Event) {
 	l.isFirstEvent = false
 }
 
+// leveledEvent is implemented by events that are not logged at the info level.
+type leveledEvent interface {
+	Level() flags.LogLevel
+}
+
+func eventLevel(event Event) flags.LogLevel {
+	if e, ok := event.(leveledEvent); ok {
+		return e.Level()
+	}
+	return flags.LogLevelInfo
+}
+
 func (l *Logger) Log(event Event) {
+	if !l.Level.Enabled(eventLevel(event)) {
+		return
+	}
+
+	if pe, ok := event.(*ProgressEvent); ok {
+		l.eta.observe(now(), pe.Current, pe.Total)
+		pe.ETA = formatETA(l.eta.estimate(pe.Total))
+	}
+
 	switch l.Mode {
 	case flags.ModeInplace:
 		if event.IsInplaceSupported() {
@@ -226,9 +401,15 @@ func (l *Logger) Log(event Event) {
 	case flags.ModeJson:
 		l.writeJson(event)
 
+	case flags.ModeNdjson:
+		l.writeNdjson(event)
+
 	case flags.ModeAppend:
 		l.writeAppend(event)
 
+	case flags.ModeNone:
+		// Progress logging is disabled.
+
 	default:
 		// we panic because errors are not captured in some log sides like
 		// jobs.RunNowAndWait
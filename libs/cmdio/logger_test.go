@@ -1,8 +1,11 @@
 package cmdio
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/databricks/cli/libs/flags"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +25,18 @@ func TestAskChoiceFailsInJsonMode(t *testing.T) {
 	assert.EqualError(t, err, "question prompts are not supported in json mode")
 }
 
+func TestLogInNoneModeIsNoop(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewLogger(flags.ModeNone)
+	l.Writer = &buf
+
+	assert.NotPanics(t, func() {
+		l.Log(&MessageEvent{Message: "hello"})
+		l.Log(&MessageEvent{Message: "world"})
+	})
+	assert.Empty(t, buf.String())
+}
+
 func TestSplitAtLastNewLine(t *testing.T) {
 	first, last := splitAtLastNewLine("hello\nworld")
 	assert.Equal(t, "hello\n", first)
@@ -43,3 +58,139 @@ func TestSplitAtLastNewLine(t *testing.T) {
 	assert.Equal(t, "\n", first)
 	assert.Equal(t, "hello world", last)
 }
+
+func TestNewLoggerResolvesAutoModeForTerminal(t *testing.T) {
+	isTerminal = func(any) bool { return true }
+	t.Cleanup(func() { isTerminal = IsTTY })
+
+	logger := newLogger(flags.ModeAuto, &bytes.Buffer{})
+	assert.Equal(t, flags.ModeInplace, logger.Mode)
+}
+
+func TestNewLoggerResolvesAutoModeForNonTerminal(t *testing.T) {
+	logger := newLogger(flags.ModeAuto, &bytes.Buffer{})
+	assert.Equal(t, flags.ModeAppend, logger.Mode)
+}
+
+func TestNewLoggerKeepsConcreteMode(t *testing.T) {
+	isTerminal = func(any) bool { return true }
+	t.Cleanup(func() { isTerminal = IsTTY })
+
+	logger := newLogger(flags.ModeJson, &bytes.Buffer{})
+	assert.Equal(t, flags.ModeJson, logger.Mode)
+}
+
+func TestLogInNdjsonModeWritesOneLinePerEvent(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewLogger(flags.ModeNdjson)
+	l.Writer = &buf
+
+	l.Log(&MessageEvent{Message: "hello"})
+	l.Log(&MessageEvent{Message: "world"})
+	assert.Equal(t, "{\"type\":\"message\",\"message\":\"hello\"}\n{\"type\":\"message\",\"message\":\"world\"}\n", buf.String())
+}
+
+func TestLogInJsonModeIncludesEventType(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewLogger(flags.ModeJson)
+	l.Writer = &buf
+
+	l.Log(&ErrorEvent{Error: "boom"})
+	assert.Equal(t, "{\n  \"type\": \"error\",\n  \"error\": \"boom\"\n}\n", buf.String())
+}
+
+func TestMarshalEventWithoutFields(t *testing.T) {
+	b, err := marshalEvent(&emptyEvent{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"empty"}`, string(b))
+}
+
+type emptyEvent struct{}
+
+func (e *emptyEvent) String() string           { return "" }
+func (e *emptyEvent) IsInplaceSupported() bool { return false }
+func (e *emptyEvent) Type() string             { return "empty" }
+
+func TestLogSkipsEventsBelowLevel(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewLogger(flags.ModeAppend)
+	l.Writer = &buf
+	l.Level = flags.LogLevelWarn
+
+	l.Log(&MessageEvent{Message: "hello"})
+	l.Log(&ErrorEvent{Error: "boom"})
+	assert.Equal(t, "Error: boom\n", buf.String())
+}
+
+func TestAskFailedInNdjsonMode(t *testing.T) {
+	l := NewLogger(flags.ModeNdjson)
+	_, err := l.Ask("What is your spirit animal?", "")
+	assert.ErrorContains(t, err, "question prompts are not supported in ndjson mode")
+}
+
+func TestLogInInplaceModeRewritesProgress(t *testing.T) {
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return ts }
+	t.Cleanup(func() { now = time.Now })
+
+	l, out := NewTestLogger(flags.ModeInplace, "")
+	l.Log(&ProgressEvent{Message: "Uploading", Current: 1, Total: 3})
+	ts = ts.Add(time.Second)
+	l.Log(&ProgressEvent{Message: "Uploading", Current: 2, Total: 3})
+	l.Log(&MessageEvent{Message: "Done"})
+	assert.Equal(t, ""+
+		"\x1b[s\x1b[u\x1b[0JUploading [1/3] ETA --\n"+
+		"\x1b[u\x1b[0JUploading [2/3] ETA 00:01\n"+
+		"Done\n", out.String())
+}
+
+func TestAskCharReadsSingleByte(t *testing.T) {
+	l, out := NewTestLogger(flags.ModeAppend, "yes")
+	r, err := l.AskChar("Continue? [y/n]", []rune{'y', 'n'})
+	assert.NoError(t, err)
+	assert.Equal(t, 'y', r)
+	assert.Equal(t, "Continue? [y/n] y\n", out.String())
+
+	// The remainder of the line is discarded.
+	rest, err := l.Reader.ReadString('\n')
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, "", rest)
+}
+
+func TestAskCharDiscardsRestOfLine(t *testing.T) {
+	l, _ := NewTestLogger(flags.ModeAppend, "yes\nn\n")
+	r, err := l.AskChar("Continue? [y/n]", []rune{'y', 'n'})
+	assert.NoError(t, err)
+	assert.Equal(t, 'y', r)
+
+	// The next prompt reads the answer on the next line.
+	r, err = l.AskChar("Continue? [y/n]", []rune{'y', 'n'})
+	assert.NoError(t, err)
+	assert.Equal(t, 'n', r)
+}
+
+func TestAskCharAnyKey(t *testing.T) {
+	l, _ := NewTestLogger(flags.ModeAppend, "x")
+	r, err := l.AskChar("Press any key to continue", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 'x', r)
+}
+
+func TestAskCharInvalidInput(t *testing.T) {
+	l, _ := NewTestLogger(flags.ModeAppend, "x")
+	_, err := l.AskChar("Continue? [y/n]", []rune{'y', 'n'})
+	assert.EqualError(t, err, `invalid input 'x': expected one of y, n`)
+}
+
+func TestAskCharNoInput(t *testing.T) {
+	l, _ := NewTestLogger(flags.ModeAppend, "")
+	_, err := l.AskChar("Continue? [y/n]", []rune{'y', 'n'})
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestAskCharFailsInJsonMode(t *testing.T) {
+	l, _ := NewTestLogger(flags.ModeAppend, "y")
+	l.Mode = flags.ModeJson
+	_, err := l.AskChar("Continue? [y/n]", []rune{'y', 'n'})
+	assert.EqualError(t, err, "question prompts are not supported in json mode")
+}
@@ -11,3 +11,7 @@ func (event *MessageEvent) String() string {
 func (event *MessageEvent) IsInplaceSupported() bool {
 	return false
 }
+
+func (event *MessageEvent) Type() string {
+	return "message"
+}
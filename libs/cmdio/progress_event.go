@@ -0,0 +1,109 @@
+package cmdio
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProgressEvent reports progress of a task with a known amount of work.
+// The logger computes the estimated time remaining when it logs the event.
+type ProgressEvent struct {
+	Message string `json:"message"`
+	Current int64  `json:"current"`
+	Total   int64  `json:"total"`
+
+	// Estimated time remaining, for example "ETA 00:42". It is set by the logger.
+	ETA string `json:"eta,omitempty"`
+}
+
+func (event *ProgressEvent) String() string {
+	s := fmt.Sprintf("%s [%d/%d]", event.Message, event.Current, event.Total)
+	if event.ETA != "" {
+		s += " " + event.ETA
+	}
+	return s
+}
+
+func (event *ProgressEvent) IsInplaceSupported() bool {
+	return true
+}
+
+func (event *ProgressEvent) Type() string {
+	return "progress"
+}
+
+// etaWindow is the period over which the rate of progress is computed.
+// It smooths out bursts while still reacting to changes in the rate.
+const etaWindow = 10 * time.Second
+
+type progressSample struct {
+	t       time.Time
+	current int64
+}
+
+// now returns the current time. It is a variable so tests can control the clock.
+var now = time.Now
+
+// etaEstimator estimates the time remaining for a task
+// from the rate of progress over a sliding window.
+type etaEstimator struct {
+	samples []progressSample
+	total   int64
+}
+
+// observe records the amount of work completed at time t.
+// A decrease in the amount of work completed or a change in the total amount of
+// work indicates that a new task started; the samples of the previous task are discarded.
+func (e *etaEstimator) observe(t time.Time, current int64, total int64) {
+	if n := len(e.samples); n > 0 && (current < e.samples[n-1].current || total != e.total) {
+		e.samples = nil
+	}
+
+	e.total = total
+	e.samples = append(e.samples, progressSample{t: t, current: current})
+
+	// Drop samples outside the window, but keep at least two to compute a rate.
+	for len(e.samples) > 2 && t.Sub(e.samples[0].t) > etaWindow {
+		e.samples = e.samples[1:]
+	}
+}
+
+// estimate returns the time remaining to complete the total amount of work.
+// It returns false if there is not enough progress to compute an estimate.
+func (e *etaEstimator) estimate(total int64) (time.Duration, bool) {
+	if len(e.samples) < 2 {
+		return 0, false
+	}
+
+	first, last := e.samples[0], e.samples[len(e.samples)-1]
+	remaining := total - last.current
+	if remaining <= 0 {
+		return 0, true
+	}
+
+	// The task is stalled if no work was completed in the window.
+	dt := last.t.Sub(first.t)
+	dc := last.current - first.current
+	if dt <= 0 || dc <= 0 {
+		return 0, false
+	}
+
+	rate := float64(dc) / dt.Seconds()
+	return time.Duration(float64(remaining) / rate * float64(time.Second)), true
+}
+
+// formatETA renders the estimated time remaining as "ETA 00:42",
+// or "ETA --" if there is no estimate.
+func formatETA(d time.Duration, ok bool) string {
+	if !ok {
+		return "ETA --"
+	}
+	d = d.Round(time.Second)
+	h := d / time.Hour
+	m := (d % time.Hour) / time.Minute
+	s := (d % time.Minute) / time.Second
+	if h > 0 {
+		return fmt.Sprintf("ETA %d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("ETA %02d:%02d", m, s)
+}
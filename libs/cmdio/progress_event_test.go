@@ -0,0 +1,105 @@
+package cmdio
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/libs/flags"
+	"github.com/stretchr/testify/assert"
+)
+
+var etaStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func observeETA(e *etaEstimator, seconds int, current int64, total int64) string {
+	e.observe(etaStart.Add(time.Duration(seconds)*time.Second), current, total)
+	return formatETA(e.estimate(total))
+}
+
+func TestETASteadyRate(t *testing.T) {
+	var e etaEstimator
+	assert.Equal(t, "ETA --", observeETA(&e, 0, 0, 100))
+	assert.Equal(t, "ETA 00:09", observeETA(&e, 1, 10, 100))
+	assert.Equal(t, "ETA 00:08", observeETA(&e, 2, 20, 100))
+	assert.Equal(t, "ETA 00:07", observeETA(&e, 3, 30, 100))
+	assert.Equal(t, "ETA 00:00", observeETA(&e, 10, 100, 100))
+}
+
+func TestETAStalled(t *testing.T) {
+	var e etaEstimator
+	observeETA(&e, 0, 10, 100)
+	assert.Equal(t, "ETA 00:08", observeETA(&e, 1, 20, 100))
+
+	// No progress for longer than the window.
+	var eta string
+	for i := 2; i <= 15; i++ {
+		eta = observeETA(&e, i, 20, 100)
+	}
+	assert.Equal(t, "ETA --", eta)
+
+	// Progress resumes.
+	assert.Equal(t, "ETA 01:10", observeETA(&e, 16, 30, 100))
+}
+
+func TestETAAcceleratingRate(t *testing.T) {
+	var e etaEstimator
+	for i := 0; i <= 5; i++ {
+		observeETA(&e, i, int64(i), 1000)
+	}
+	assert.Equal(t, "ETA 16:35", formatETA(e.estimate(1000)))
+
+	// The rate increases tenfold. The estimate reflects the rate over the window.
+	var eta string
+	for i := 6; i <= 20; i++ {
+		eta = observeETA(&e, i, 5+int64(i-5)*10, 1000)
+	}
+	assert.Equal(t, "ETA 01:25", eta)
+}
+
+func TestETAResetsWhenCurrentRestarts(t *testing.T) {
+	var e etaEstimator
+	observeETA(&e, 0, 0, 100)
+	assert.Equal(t, "ETA 00:09", observeETA(&e, 1, 10, 100))
+
+	// A new task starts with the same total. Its rate is unknown.
+	assert.Equal(t, "ETA --", observeETA(&e, 2, 0, 100))
+	assert.Equal(t, "ETA 03:18", observeETA(&e, 4, 1, 100))
+}
+
+func TestETAResetsWhenTotalChanges(t *testing.T) {
+	var e etaEstimator
+	observeETA(&e, 0, 0, 100)
+	assert.Equal(t, "ETA 00:09", observeETA(&e, 1, 10, 100))
+
+	// A new task starts with a different total. Its rate is unknown.
+	assert.Equal(t, "ETA --", observeETA(&e, 2, 20, 1000))
+	assert.Equal(t, "ETA 00:48", observeETA(&e, 3, 40, 1000))
+}
+
+func TestFormatETA(t *testing.T) {
+	assert.Equal(t, "ETA --", formatETA(0, false))
+	assert.Equal(t, "ETA 00:42", formatETA(42*time.Second, true))
+	assert.Equal(t, "ETA 02:05", formatETA(125400*time.Millisecond, true))
+	assert.Equal(t, "ETA 1:01:01", formatETA(time.Hour+time.Minute+time.Second, true))
+}
+
+func TestLogProgressEvent(t *testing.T) {
+	i := 0
+	now = func() time.Time {
+		i++
+		return etaStart.Add(time.Duration(i) * time.Second)
+	}
+	t.Cleanup(func() { now = time.Now })
+
+	var buf bytes.Buffer
+	l := NewLogger(flags.ModeAppend)
+	l.Writer = &buf
+
+	l.Log(&ProgressEvent{Message: "Uploading", Current: 0, Total: 4})
+	l.Log(&ProgressEvent{Message: "Uploading", Current: 1, Total: 4})
+	l.Log(&ProgressEvent{Message: "Uploading", Current: 4, Total: 4})
+	assert.Equal(t, ""+
+		"Uploading [0/4] ETA --\n"+
+		"Uploading [1/4] ETA 00:03\n"+
+		"Uploading [4/4] ETA 00:00\n", buf.String())
+}
@@ -8,10 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
 	"strings"
 	"text/tabwriter"
 	"text/template"
 	"time"
+	"unicode/utf8"
 
 	"github.com/databricks/cli/libs/flags"
 	"github.com/databricks/databricks-sdk-go/listing"
@@ -73,6 +75,12 @@ type templateRenderer interface {
 	renderTemplate(context.Context, *template.Template, *tabwriter.Writer) error
 }
 
+type itemsRenderer interface {
+	// Return all items to render, if they are known before rendering.
+	// Iterators that stream their items do not know them in advance.
+	items() (any, bool)
+}
+
 type readerRenderer struct {
 	reader io.Reader
 }
@@ -87,6 +95,14 @@ type iteratorRenderer[T any] struct {
 	bufferSize int
 }
 
+func (ir iteratorRenderer[T]) items() (any, bool) {
+	// Sorted iterators hold all their items in memory.
+	if s, ok := ir.t.(*sliceIterator[T]); ok {
+		return s.items, true
+	}
+	return nil, false
+}
+
 func (ir iteratorRenderer[T]) getBufferSize() int {
 	if ir.bufferSize == 0 {
 		return 20
@@ -166,8 +182,8 @@ type defaultRenderer struct {
 	t any
 }
 
-func (d defaultRenderer) renderJson(_ context.Context, w writeFlusher) error {
-	pretty, err := fancyJSON(d.t)
+func (d defaultRenderer) renderJson(ctx context.Context, w writeFlusher) error {
+	pretty, err := fancyJSON(d.t, IsColorEnabled(ctx))
 	if err != nil {
 		return err
 	}
@@ -182,6 +198,10 @@ func (d defaultRenderer) renderJson(_ context.Context, w writeFlusher) error {
 	return w.Flush()
 }
 
+func (d defaultRenderer) items() (any, bool) {
+	return d.t, true
+}
+
 func (d defaultRenderer) renderTemplate(_ context.Context, t *template.Template, w *tabwriter.Writer) error {
 	return t.Execute(w, d.t)
 }
@@ -295,24 +315,33 @@ func RenderIteratorJson[T any](ctx context.Context, i listing.Iterator[T]) error
 
 func renderUsingTemplate(ctx context.Context, r templateRenderer, w io.Writer, headerTmpl, tmpl string) error {
 	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	colored := IsColorEnabled(ctx)
+
+	// Capture all items before rendering (if possible),
+	// so that functions can consider all of them.
+	var items any
+	var known bool
+	if ir, ok := r.(itemsRenderer); ok {
+		items, known = ir.items()
+	}
+
 	base := template.New("command").Funcs(template.FuncMap{
-		// we render colored output if stdout is TTY, otherwise we render text.
-		// in the future we'll check if we can explicitly check for stderr being
-		// a TTY
-		"header":  color.BlueString,
-		"red":     color.RedString,
-		"green":   color.GreenString,
-		"blue":    color.BlueString,
-		"yellow":  color.YellowString,
-		"magenta": color.MagentaString,
-		"cyan":    color.CyanString,
+		// we render colored output according to the configured color mode.
+		// by default, output is colored if stdout is a TTY.
+		"header":  newColor(colored, color.FgBlue).SprintfFunc(),
+		"red":     newColor(colored, color.FgRed).SprintfFunc(),
+		"green":   newColor(colored, color.FgGreen).SprintfFunc(),
+		"blue":    newColor(colored, color.FgBlue).SprintfFunc(),
+		"yellow":  newColor(colored, color.FgYellow).SprintfFunc(),
+		"magenta": newColor(colored, color.FgMagenta).SprintfFunc(),
+		"cyan":    newColor(colored, color.FgCyan).SprintfFunc(),
 		"replace": strings.ReplaceAll,
 		"join":    strings.Join,
 		"bool": func(v bool) string {
 			if v {
-				return color.GreenString("YES")
+				return newColor(colored, color.FgGreen).Sprint("YES")
 			}
-			return color.RedString("NO")
+			return newColor(colored, color.FgRed).Sprint("NO")
 		},
 		"pretty_json": func(in string) (string, error) {
 			var tmp any
@@ -320,7 +349,7 @@ func renderUsingTemplate(ctx context.Context, r templateRenderer, w io.Writer, h
 			if err != nil {
 				return "", err
 			}
-			b, err := fancyJSON(tmp)
+			b, err := fancyJSON(tmp, colored)
 			if err != nil {
 				return "", err
 			}
@@ -329,6 +358,16 @@ func renderUsingTemplate(ctx context.Context, r templateRenderer, w io.Writer, h
 		"pretty_date": func(t time.Time) string {
 			return t.Format("2006-01-02T15:04:05Z")
 		},
+		"column_nonempty": func(field string) (bool, error) {
+			if !known {
+				// Show the column if the items are streamed.
+				return true, nil
+			}
+			return columnNonEmpty(items, field)
+		},
+		"get":      get,
+		"truncate": truncate,
+		"rjust":    rjust,
 		"b64_encode": func(in string) (string, error) {
 			var out bytes.Buffer
 			enc := base64.NewEncoder(base64.StdEncoding, &out)
@@ -351,7 +390,15 @@ func renderUsingTemplate(ctx context.Context, r templateRenderer, w io.Writer, h
 			return string(out), nil
 		},
 	})
-	if headerTmpl != "" {
+	// Render missing map keys as the zero value of the map's element type instead of "<no value>".
+	// This does not apply to maps with interface elements (e.g. map[string]any): the zero
+	// value is a nil interface, which still renders as "<no value>". Use "get" for those.
+	base = base.Option("missingkey=zero")
+	c := fromContext(ctx)
+	if len(c.templateFuncs) > 0 {
+		base = base.Funcs(c.templateFuncs)
+	}
+	if headerTmpl != "" && !c.noHeader {
 		headerT, err := base.Parse(headerTmpl)
 		if err != nil {
 			return err
@@ -374,19 +421,114 @@ func renderUsingTemplate(ctx context.Context, r templateRenderer, w io.Writer, h
 	return tw.Flush()
 }
 
-func fancyJSON(v any) ([]byte, error) {
+// get returns the value at the specified path of field names or map keys.
+// It returns an empty string if any value along the path is nil or missing,
+// so that templates can access optional nested fields without failing.
+func get(v any, path ...string) any {
+	rv := reflect.ValueOf(v)
+	for _, name := range path {
+		for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+			if rv.IsNil() {
+				return ""
+			}
+			rv = rv.Elem()
+		}
+		switch rv.Kind() {
+		case reflect.Struct:
+			rv = rv.FieldByName(name)
+		case reflect.Map:
+			rv = rv.MapIndex(reflect.ValueOf(name))
+		default:
+			return ""
+		}
+		if !rv.IsValid() {
+			return ""
+		}
+	}
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return ""
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return ""
+	}
+	return rv.Interface()
+}
+
+// columnNonEmpty returns true if the specified field is set for any of the items.
+// The items are either a slice of structs or a single struct (or pointers to structs).
+func columnNonEmpty(items any, field string) (bool, error) {
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		v = reflect.ValueOf([]any{items})
+	}
+	for i := 0; i < v.Len(); i++ {
+		e := v.Index(i)
+		for e.Kind() == reflect.Pointer || e.Kind() == reflect.Interface {
+			if e.IsNil() {
+				break
+			}
+			e = e.Elem()
+		}
+		if e.Kind() != reflect.Struct {
+			continue
+		}
+		f := e.FieldByName(field)
+		if !f.IsValid() {
+			return false, fmt.Errorf("field %q not found in %s", field, e.Type())
+		}
+		if !f.IsZero() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rjust right-aligns s in a field of n runes by padding it with spaces.
+// It can be used to right-align numeric columns in tables.
+func rjust(n int, s string) string {
+	pad := n - utf8.RuneCountInString(s)
+	if pad <= 0 {
+		return s
+	}
+	return strings.Repeat(" ", pad) + s
+}
+
+// truncate shortens s to at most n runes.
+// If s is truncated, its last rune is replaced by an ellipsis.
+func truncate(n int, s string) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	if n <= 0 {
+		return ""
+	}
+	return string(r[:n-1]) + "…"
+}
+
+func fancyJSON(v any, colored bool) ([]byte, error) {
 	// create custom formatter
 	f := jsoncolor.NewFormatter()
 
 	// set custom colors
-	f.StringColor = color.New(color.FgGreen)
-	f.TrueColor = color.New(color.FgGreen, color.Bold)
-	f.FalseColor = color.New(color.FgRed)
-	f.NumberColor = color.New(color.FgCyan)
-	f.NullColor = color.New(color.FgMagenta)
-	f.ObjectColor = color.New(color.Reset)
-	f.CommaColor = color.New(color.Reset)
-	f.ColonColor = color.New(color.Reset)
+	f.StringColor = newColor(colored, color.FgGreen)
+	f.TrueColor = newColor(colored, color.FgGreen, color.Bold)
+	f.FalseColor = newColor(colored, color.FgRed)
+	f.NumberColor = newColor(colored, color.FgCyan)
+	f.NullColor = newColor(colored, color.FgMagenta)
+	f.ObjectColor = newColor(colored, color.Reset)
+	f.CommaColor = newColor(colored, color.Reset)
+	f.ColonColor = newColor(colored, color.Reset)
+
+	// set default colors so they also respect the color mode
+	f.SpaceColor = newColor(colored)
+	f.ArrayColor = newColor(colored, color.Bold)
+	f.FieldQuoteColor = newColor(colored, color.FgBlue, color.Bold)
+	f.FieldColor = newColor(colored, color.FgBlue, color.Bold)
+	f.StringQuoteColor = newColor(colored, color.FgGreen)
 
 	return jsoncolor.MarshalIndentWithFormatter(v, "", "  ", f)
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"text/template"
 
 	"github.com/databricks/cli/libs/flags"
 	"github.com/databricks/databricks-sdk-go/listing"
@@ -188,3 +189,211 @@ func TestRender(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderTemplateColorMode(t *testing.T) {
+	for _, c := range []struct {
+		mode     flags.ColorMode
+		expected string
+	}{
+		{flags.ColorAuto, "hello"},
+		{flags.ColorNever, "hello"},
+		{flags.ColorAlways, "\x1b[31mhello\x1b[0m"},
+	} {
+		t.Run(string(c.mode), func(t *testing.T) {
+			output := &bytes.Buffer{}
+			cmdIO := NewIO(flags.OutputText, nil, output, output, "", `{{red "%s" .}}`)
+			cmdIO.SetColorMode(c.mode)
+			ctx := InContext(context.Background(), cmdIO)
+			err := Render(ctx, "hello")
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, output.String())
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "hello", truncate(10, "hello"))
+	assert.Equal(t, "hello", truncate(5, "hello"))
+	assert.Equal(t, "hel…", truncate(4, "hello"))
+	assert.Equal(t, "…", truncate(1, "hello"))
+	assert.Equal(t, "", truncate(0, "hello"))
+}
+
+func TestRenderTruncateMultibyte(t *testing.T) {
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(flags.OutputText, nil, output, output, "", `{{truncate 5 .}}`)
+	ctx := InContext(context.Background(), cmdIO)
+	err := Render(ctx, "日本語のコメント")
+	assert.NoError(t, err)
+	assert.Equal(t, "日本語の…", output.String())
+}
+
+func TestRenderWithCustomTemplateFuncs(t *testing.T) {
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(flags.OutputText, nil, output, output, `{{upper "id"}}`, `{{upper .}} {{truncate 3 .}}`)
+	cmdIO.SetTemplateFuncs(template.FuncMap{
+		"upper": strings.ToUpper,
+		// Custom functions take precedence over built-in ones.
+		"truncate": func(n int, s string) string {
+			return s[:n]
+		},
+	})
+	ctx := InContext(context.Background(), cmdIO)
+	err := Render(ctx, "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "ID\nHELLO hel", output.String())
+}
+
+func TestRjust(t *testing.T) {
+	assert.Equal(t, "   42", rjust(5, "42"))
+	assert.Equal(t, "  日本", rjust(4, "日本"))
+	assert.Equal(t, "12345", rjust(3, "12345"))
+}
+
+func TestRenderRightAlignedColumn(t *testing.T) {
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(flags.OutputText, nil, output, output, `{{"Name"}}	{{rjust 5 "Count"}}`, `{{range .}}{{.Name}}	{{rjust 5 (printf "%d" .Count)}}
+{{end}}`)
+	ctx := InContext(context.Background(), cmdIO)
+	err := Render(ctx, []struct {
+		Name  string
+		Count int
+	}{
+		{"apples", 3},
+		{"kiwis", 12345},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ""+
+		"Name    Count\n"+
+		"apples      3\n"+
+		"kiwis   12345\n", output.String())
+}
+
+func TestRenderColumnNonEmpty(t *testing.T) {
+	type row struct {
+		Name    string
+		Comment string
+	}
+
+	header := `{{"Name"}}{{if column_nonempty "Comment"}}	{{"Comment"}}{{end}}`
+	tmpl := `{{range .}}{{.Name}}{{if column_nonempty "Comment"}}	{{.Comment}}{{end}}
+{{end}}`
+
+	for _, c := range []struct {
+		name     string
+		v        any
+		expected string
+	}{
+		{
+			name: "non-empty",
+			v:    []row{{"a", ""}, {"b", "hello"}},
+			expected: "" +
+				"Name  Comment\n" +
+				"a     \n" +
+				"b     hello\n",
+		},
+		{
+			name: "empty",
+			v:    []row{{"a", ""}, {"b", ""}},
+			expected: "" +
+				"Name\n" +
+				"a\n" +
+				"b\n",
+		},
+		{
+			name: "buffered iterator",
+			v:    &sliceIterator[*row]{items: []*row{{"a", ""}, {"b", ""}}},
+			expected: "" +
+				"Name\n" +
+				"a\n" +
+				"b\n",
+		},
+		{
+			// Items of streamed iterators are not known in advance.
+			name: "streamed iterator",
+			v:    FilterIterator[*row](&sliceIterator[*row]{items: []*row{{"a", ""}}}, func(*row) bool { return true }),
+			expected: "" +
+				"Name  Comment\n" +
+				"a     \n",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			cmdIO := NewIO(flags.OutputText, nil, output, output, header, tmpl)
+			ctx := InContext(context.Background(), cmdIO)
+			var err error
+			if it, ok := c.v.(listing.Iterator[*row]); ok {
+				err = RenderIterator(ctx, it)
+			} else {
+				err = Render(ctx, c.v)
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, output.String())
+		})
+	}
+}
+
+func TestColumnNonEmptyUnknownField(t *testing.T) {
+	_, err := columnNonEmpty([]struct{ Name string }{{"a"}}, "Comment")
+	assert.EqualError(t, err, `field "Comment" not found in struct { Name string }`)
+}
+
+func TestGet(t *testing.T) {
+	type inner struct {
+		Name string
+	}
+	type outer struct {
+		Inner  *inner
+		Labels map[string]string
+	}
+
+	assert.Equal(t, "foo", get(outer{Inner: &inner{Name: "foo"}}, "Inner", "Name"))
+	assert.Equal(t, "", get(outer{}, "Inner", "Name"))
+	assert.Equal(t, "", get(&outer{}, "Unknown"))
+	assert.Equal(t, "bar", get(outer{Labels: map[string]string{"foo": "bar"}}, "Labels", "foo"))
+	assert.Equal(t, "", get(outer{Labels: map[string]string{}}, "Labels", "foo"))
+	assert.Equal(t, "", get(outer{}, "Labels", "foo"))
+	assert.Equal(t, "", get(nil, "Inner"))
+	assert.Equal(t, "", get((*outer)(nil), "Inner"))
+}
+
+func TestRenderMissingFields(t *testing.T) {
+	type inner struct {
+		Name string
+	}
+	type row struct {
+		ID     string
+		Inner  *inner
+		Labels map[string]string
+	}
+
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(flags.OutputText, nil, output, output, "", `{{range .}}{{.ID}}	{{get . "Inner" "Name"}}	{{.Labels.env}}
+{{end}}`)
+	ctx := InContext(context.Background(), cmdIO)
+	err := Render(ctx, []row{
+		{ID: "a", Inner: &inner{Name: "x"}, Labels: map[string]string{"env": "prod"}},
+		{ID: "b"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ""+
+		"a  x  prod\n"+
+		"b     \n", output.String())
+}
+
+func TestRenderMissingKeysOfMapWithInterfaceValues(t *testing.T) {
+	output := &bytes.Buffer{}
+	cmdIO := NewIO(flags.OutputText, nil, output, output, "", `{{range .}}{{.id}}	{{.env}}	{{get . "env"}}
+{{end}}`)
+	ctx := InContext(context.Background(), cmdIO)
+	err := Render(ctx, []map[string]any{
+		{"id": "a", "env": "prod"},
+		{"id": "b"},
+	})
+	assert.NoError(t, err)
+
+	// Missing keys of maps with interface values are not affected by missingkey=zero.
+	assert.Equal(t, ""+
+		"a  prod        prod\n"+
+		"b  <no value>  \n", output.String())
+}
@@ -0,0 +1,36 @@
+package cmdio
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SortKey describes a column that the output of a list command can be sorted by.
+type SortKey[T any] struct {
+	// Name of the column, as specified on the command line.
+	Name string
+
+	// Compare orders two items by the value of the column.
+	Compare func(a, b T) int
+}
+
+// SortKeyCompare returns the compare function of the sort key with the
+// specified name (case-insensitive). If reverse is true, the order is reversed.
+func SortKeyCompare[T any](keys []SortKey[T], name string, reverse bool) (func(a, b T) int, error) {
+	for _, key := range keys {
+		if !strings.EqualFold(key.Name, name) {
+			continue
+		}
+		cmp := key.Compare
+		if reverse {
+			return func(a, b T) int { return cmp(b, a) }, nil
+		}
+		return cmp, nil
+	}
+
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = key.Name
+	}
+	return nil, fmt.Errorf("invalid sort column %q: must be one of %s", name, strings.Join(names, ", "))
+}
@@ -0,0 +1,47 @@
+package cmdio
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sortTestItem struct {
+	name string
+	size int
+}
+
+var sortTestKeys = []SortKey[sortTestItem]{
+	{
+		Name:    "name",
+		Compare: func(a, b sortTestItem) int { return cmp.Compare(a.name, b.name) },
+	},
+	{
+		Name:    "size",
+		Compare: func(a, b sortTestItem) int { return cmp.Compare(a.size, b.size) },
+	},
+}
+
+func TestSortKeyCompare(t *testing.T) {
+	a := sortTestItem{name: "a", size: 2}
+	b := sortTestItem{name: "b", size: 1}
+
+	fn, err := SortKeyCompare(sortTestKeys, "name", false)
+	require.NoError(t, err)
+	assert.Equal(t, -1, fn(a, b))
+
+	fn, err = SortKeyCompare(sortTestKeys, "SIZE", false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fn(a, b))
+
+	fn, err = SortKeyCompare(sortTestKeys, "size", true)
+	require.NoError(t, err)
+	assert.Equal(t, -1, fn(a, b))
+}
+
+func TestSortKeyCompareInvalidName(t *testing.T) {
+	_, err := SortKeyCompare(sortTestKeys, "color", false)
+	assert.EqualError(t, err, `invalid sort column "color": must be one of name, size`)
+}
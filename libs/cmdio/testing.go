@@ -2,8 +2,12 @@ package cmdio
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"io"
+	"strings"
+
+	"github.com/databricks/cli/libs/flags"
 )
 
 type Test struct {
@@ -44,3 +48,13 @@ func SetupTest(ctx context.Context) (context.Context, *Test) {
 		Stderr: bufio.NewReader(rerr),
 	}
 }
+
+// NewTestLogger returns a logger that writes to the returned buffer and reads
+// answers to prompts from the specified input. The auto mode resolves to append.
+func NewTestLogger(mode flags.ProgressLogFormat, input string) (*Logger, *bytes.Buffer) {
+	var out bytes.Buffer
+	l := newLogger(mode, &out)
+	l.Reader = *bufio.NewReader(strings.NewReader(input))
+	l.in = nil
+	return l, &out
+}
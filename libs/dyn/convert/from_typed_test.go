@@ -619,3 +619,42 @@ func TestFromTypedFloatTypeError(t *testing.T) {
 	_, err := FromTyped(src, ref)
 	require.Error(t, err)
 }
+
+func TestFromTypedStructVariableReference(t *testing.T) {
+	type Tmp struct {
+		Foo string `json:"foo"`
+	}
+
+	src := Tmp{}
+	ref := dyn.V("${var.foo}")
+	nv, err := FromTyped(src, ref)
+	require.NoError(t, err)
+	assert.Equal(t, dyn.V("${var.foo}"), nv)
+}
+
+func TestFromTypedSliceVariableReference(t *testing.T) {
+	var src []string
+	ref := dyn.V("${var.foo}")
+	nv, err := FromTyped(src, ref)
+	require.NoError(t, err)
+	assert.Equal(t, dyn.V("${var.foo}"), nv)
+}
+
+func TestFromTypedInterface(t *testing.T) {
+	src := map[string]any{
+		"foo": "bar",
+		"baz": nil,
+		"seq": []any{int64(1), true},
+	}
+	ref := dyn.V(map[string]dyn.Value{
+		"foo": dyn.NewValue("bar", dyn.Location{File: "foo.yml", Line: 1}),
+	})
+	nv, err := FromTyped(src, ref)
+	require.NoError(t, err)
+	assert.Equal(t, dyn.Location{File: "foo.yml", Line: 1}, nv.Get("foo").Location())
+	assert.Equal(t, map[string]any{
+		"foo": "bar",
+		"baz": nil,
+		"seq": []any{int64(1), true},
+	}, nv.AsAny())
+}
@@ -5,10 +5,13 @@ import (
 	"reflect"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/cli/libs/dyn"
 	"github.com/databricks/cli/libs/dyn/dynvar"
+	"github.com/databricks/cli/libs/textutil"
+	"golang.org/x/exp/maps"
 )
 
 // NormalizeOption is the type for options that can be passed to Normalize.
@@ -56,6 +59,9 @@ func (n normalizeOptions) normalizeType(typ reflect.Type, src dyn.Value, seen []
 		return n.normalizeInt(typ, src)
 	case reflect.Float32, reflect.Float64:
 		return n.normalizeFloat(typ, src)
+	case reflect.Interface:
+		// Values of interface types can hold any configuration value.
+		return src, nil
 	}
 
 	return dyn.InvalidValue, diag.Errorf("unsupported type: %s", typ.Kind())
@@ -69,6 +75,22 @@ func typeMismatch(expected dyn.Kind, src dyn.Value) diag.Diagnostic {
 	}
 }
 
+const unknownFieldPrefix = "unknown field: "
+
+// IsUnknownField returns true if the diagnostic reports a field that is not defined on a struct.
+func IsUnknownField(d diag.Diagnostic) bool {
+	return d.Severity == diag.Warning && strings.HasPrefix(d.Summary, unknownFieldPrefix)
+}
+
+// unknownField returns the summary for a field that is not defined on a struct,
+// including a suggestion if the field looks like a misspelling of a known field.
+func unknownField(k string, info structInfo) string {
+	if match := textutil.ClosestMatch(k, maps.Keys(info.Fields)); match != "" {
+		return fmt.Sprintf("%s%s (did you mean %s?)", unknownFieldPrefix, k, match)
+	}
+	return unknownFieldPrefix + k
+}
+
 func (n normalizeOptions) normalizeStruct(typ reflect.Type, src dyn.Value, seen []reflect.Type) (dyn.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -81,7 +103,7 @@ func (n normalizeOptions) normalizeStruct(typ reflect.Type, src dyn.Value, seen
 			if !ok {
 				diags = diags.Append(diag.Diagnostic{
 					Severity: diag.Warning,
-					Summary:  fmt.Sprintf("unknown field: %s", k),
+					Summary:  unknownField(k, info),
 					Location: src.Location(),
 				})
 				continue
@@ -150,6 +172,11 @@ func (n normalizeOptions) normalizeStruct(typ reflect.Type, src dyn.Value, seen
 		return dyn.NewValue(out, src.Location()), diags
 	case dyn.KindNil:
 		return src, diags
+	case dyn.KindString:
+		// Return verbatim if it's a pure variable reference.
+		if dynvar.IsPureVariableReference(src.MustString()) {
+			return src, diags
+		}
 	}
 
 	return dyn.InvalidValue, diags.Append(typeMismatch(dyn.KindMap, src))
@@ -178,6 +205,11 @@ func (n normalizeOptions) normalizeMap(typ reflect.Type, src dyn.Value, seen []r
 		return dyn.NewValue(out, src.Location()), diags
 	case dyn.KindNil:
 		return src, diags
+	case dyn.KindString:
+		// Return verbatim if it's a pure variable reference.
+		if dynvar.IsPureVariableReference(src.MustString()) {
+			return src, diags
+		}
 	}
 
 	return dyn.InvalidValue, diags.Append(typeMismatch(dyn.KindMap, src))
@@ -206,6 +238,11 @@ func (n normalizeOptions) normalizeSlice(typ reflect.Type, src dyn.Value, seen [
 		return dyn.NewValue(out, src.Location()), diags
 	case dyn.KindNil:
 		return src, diags
+	case dyn.KindString:
+		// Return verbatim if it's a pure variable reference.
+		if dynvar.IsPureVariableReference(src.MustString()) {
+			return src, diags
+		}
 	}
 
 	return dyn.InvalidValue, diags.Append(typeMismatch(dyn.KindSequence, src))
@@ -69,6 +69,7 @@ func TestNormalizeStructUnknownField(t *testing.T) {
 		Summary:  `unknown field: bar`,
 		Location: vin.Get("foo").Location(),
 	}, err[0])
+	assert.True(t, IsUnknownField(err[0]))
 
 	// The field that can be mapped to the struct field is retained.
 	assert.Equal(t, map[string]any{
@@ -76,6 +77,22 @@ func TestNormalizeStructUnknownField(t *testing.T) {
 	}, vout.AsAny())
 }
 
+func TestNormalizeStructUnknownFieldSuggestion(t *testing.T) {
+	type Tmp struct {
+		Resources string `json:"resources"`
+	}
+
+	var typ Tmp
+	vin := dyn.V(map[string]dyn.Value{
+		"resource": dyn.V("bar"),
+	})
+
+	_, err := Normalize(typ, vin)
+	assert.Len(t, err, 1)
+	assert.Equal(t, diag.Warning, err[0].Severity)
+	assert.Equal(t, `unknown field: resource (did you mean resources?)`, err[0].Summary)
+}
+
 func TestNormalizeStructNil(t *testing.T) {
 	type Tmp struct {
 		Foo string `json:"foo"`
@@ -641,3 +658,42 @@ func TestNormalizeFloatError(t *testing.T) {
 		Location: dyn.Location{},
 	}, err[0])
 }
+
+func TestNormalizeStructFromStringVariableReference(t *testing.T) {
+	type Tmp struct {
+		Foo string `json:"foo"`
+	}
+
+	var typ Tmp
+	vin := dyn.V("${var.foo}")
+	vout, err := Normalize(typ, vin)
+	assert.Empty(t, err)
+	assert.Equal(t, vin, vout)
+}
+
+func TestNormalizeMapAndSliceFromStringVariableReference(t *testing.T) {
+	var typ struct {
+		Map   map[string]string `json:"map"`
+		Slice []string          `json:"slice"`
+	}
+
+	vin := dyn.V(map[string]dyn.Value{
+		"map":   dyn.V("${var.map}"),
+		"slice": dyn.V("${var.slice}"),
+	})
+	vout, err := Normalize(typ, vin)
+	assert.Empty(t, err)
+	assert.Equal(t, vin, vout)
+}
+
+func TestNormalizeInterface(t *testing.T) {
+	var typ map[string]any
+	vin := dyn.V(map[string]dyn.Value{
+		"foo": dyn.V(map[string]dyn.Value{
+			"bar": dyn.V(int64(1)),
+		}),
+	})
+	vout, err := Normalize(typ, vin)
+	assert.Empty(t, err)
+	assert.Equal(t, vin, vout)
+}
@@ -511,3 +511,36 @@ func TestToTypedWithAliasKeyType(t *testing.T) {
 	assert.Equal(t, "bar", out["foo"])
 	assert.Equal(t, "baz", out["bar"])
 }
+
+func TestToTypedStructFromStringVariableReference(t *testing.T) {
+	type Tmp struct {
+		Foo string `json:"foo"`
+	}
+
+	out := Tmp{Foo: "bar"}
+	err := ToTyped(&out, dyn.V("${var.foo}"))
+	require.NoError(t, err)
+	assert.Equal(t, Tmp{}, out)
+}
+
+func TestToTypedSliceFromStringVariableReference(t *testing.T) {
+	out := []string{"foo"}
+	err := ToTyped(&out, dyn.V("${var.foo}"))
+	require.NoError(t, err)
+	assert.Nil(t, out)
+}
+
+func TestToTypedInterface(t *testing.T) {
+	var out map[string]any
+	err := ToTyped(&out, dyn.V(map[string]dyn.Value{
+		"foo": dyn.V(map[string]dyn.Value{
+			"bar": dyn.V(int64(1)),
+		}),
+		"baz": dyn.NilValue,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"foo": map[string]any{"bar": int64(1)},
+		"baz": nil,
+	}, out)
+}
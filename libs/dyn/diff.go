@@ -0,0 +1,65 @@
+package dyn
+
+import (
+	"reflect"
+	"slices"
+)
+
+// Change describes a difference between two configuration trees.
+// Before is [InvalidValue] if the value was added, and After is
+// [InvalidValue] if the value was removed.
+type Change struct {
+	Path   Path
+	Before Value
+	After  Value
+}
+
+// Diff returns the changes needed to turn a into b, ordered by path.
+// Only the values of nodes are compared; locations are ignored.
+func Diff(a, b Value) []Change {
+	return diff(EmptyPath, a, b, nil)
+}
+
+func diff(p Path, a, b Value, out []Change) []Change {
+	if a.Kind() != b.Kind() {
+		return append(out, Change{Path: p, Before: a, After: b})
+	}
+
+	switch a.Kind() {
+	case KindMap:
+		am := a.MustMap()
+		bm := b.MustMap()
+		keys := make([]string, 0, len(am)+len(bm))
+		for k := range am {
+			keys = append(keys, k)
+		}
+		for k := range bm {
+			if _, ok := am[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			out = diff(p.Append(Key(k)), am[k], bm[k], out)
+		}
+	case KindSequence:
+		as := a.MustSequence()
+		bs := b.MustSequence()
+		for i := 0; i < max(len(as), len(bs)); i++ {
+			var av, bv Value
+			if i < len(as) {
+				av = as[i]
+			}
+			if i < len(bs) {
+				bv = bs[i]
+			}
+			out = diff(p.Append(Index(i)), av, bv, out)
+		}
+	default:
+		if !reflect.DeepEqual(a.Value(), b.Value()) {
+			out = append(out, Change{Path: p, Before: a, After: b})
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,49 @@
+package dyn_test
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffEqual(t *testing.T) {
+	v := dyn.V(map[string]dyn.Value{
+		"foo": dyn.V("bar"),
+		"seq": dyn.V([]dyn.Value{dyn.V(1), dyn.V(2)}),
+	})
+	assert.Empty(t, dyn.Diff(v, v))
+}
+
+func TestDiffIgnoresLocation(t *testing.T) {
+	a := dyn.NewValue("bar", dyn.Location{File: "a.yml", Line: 1})
+	b := dyn.NewValue("bar", dyn.Location{File: "b.yml", Line: 2})
+	assert.Empty(t, dyn.Diff(a, b))
+}
+
+func TestDiff(t *testing.T) {
+	a := dyn.V(map[string]dyn.Value{
+		"changed": dyn.V("a"),
+		"removed": dyn.V("a"),
+		"same":    dyn.V("a"),
+		"kind":    dyn.V("a"),
+		"seq":     dyn.V([]dyn.Value{dyn.V(1), dyn.V(2)}),
+	})
+	b := dyn.V(map[string]dyn.Value{
+		"added":   dyn.V("b"),
+		"changed": dyn.V("b"),
+		"same":    dyn.V("a"),
+		"kind":    dyn.V(1),
+		"seq":     dyn.V([]dyn.Value{dyn.V(1), dyn.V(3), dyn.V(4)}),
+	})
+
+	changes := dyn.Diff(a, b)
+	assert.Equal(t, []dyn.Change{
+		{Path: dyn.MustPathFromString("added"), Before: dyn.InvalidValue, After: dyn.V("b")},
+		{Path: dyn.MustPathFromString("changed"), Before: dyn.V("a"), After: dyn.V("b")},
+		{Path: dyn.MustPathFromString("kind"), Before: dyn.V("a"), After: dyn.V(1)},
+		{Path: dyn.MustPathFromString("removed"), Before: dyn.V("a"), After: dyn.InvalidValue},
+		{Path: dyn.MustPathFromString("seq[1]"), Before: dyn.V(2), After: dyn.V(3)},
+		{Path: dyn.MustPathFromString("seq[2]"), Before: dyn.InvalidValue, After: dyn.V(4)},
+	}, changes)
+}
@@ -71,3 +71,13 @@ func (v ref) references() []string {
 func IsPureVariableReference(s string) bool {
 	return len(s) > 0 && re.FindString(s) == s
 }
+
+// References returns the paths of all variable references in the given string.
+// For example, "${a.b} and ${c}" returns ["a.b", "c"].
+func References(s string) []string {
+	var out []string
+	for _, m := range re.FindAllStringSubmatch(s, -1) {
+		out = append(out, m[1])
+	}
+	return out
+}
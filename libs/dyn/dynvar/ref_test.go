@@ -51,3 +51,9 @@ func TestIsPureVariableReference(t *testing.T) {
 	assert.False(t, IsPureVariableReference("prefix ${foo.bar}"))
 	assert.True(t, IsPureVariableReference("${foo.bar}"))
 }
+
+func TestReferences(t *testing.T) {
+	assert.Nil(t, References("no references"))
+	assert.Equal(t, []string{"foo.bar"}, References("${foo.bar}"))
+	assert.Equal(t, []string{"a.b", "c"}, References("${a.b} and ${c}"))
+}
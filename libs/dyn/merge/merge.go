@@ -2,21 +2,67 @@ package merge
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/databricks/cli/libs/dyn"
 )
 
+// Option configures the behavior of [Merge].
+type Option func(*merger)
+
+// SequenceStrategy determines how [Merge] merges two sequences.
+type SequenceStrategy int
+
+const (
+	// SequenceAppend concatenates the sequences.
+	SequenceAppend SequenceStrategy = iota
+
+	// SequenceReplace replaces the first sequence with the second one.
+	SequenceReplace
+)
+
+// WithSequenceStrategy configures [Merge] to call the specified function for
+// every pair of sequences it merges, to determine the strategy to merge them with.
+// The path passed to the function is relative to the values passed to [Merge].
+// It must not be retained; it must be copied if it is needed after the function returns.
+func WithSequenceStrategy(fn func(p dyn.Path, a, b dyn.Value) SequenceStrategy) Option {
+	return func(m *merger) {
+		m.strategy = fn
+	}
+}
+
+// WithReplace configures [Merge] to replace sequences whose path matches any of
+// the specified patterns, instead of concatenating them. Paths are relative to the
+// values passed to [Merge].
+func WithReplace(patterns ...dyn.Pattern) Option {
+	return WithSequenceStrategy(func(p dyn.Path, a, b dyn.Value) SequenceStrategy {
+		if slices.ContainsFunc(patterns, func(pattern dyn.Pattern) bool { return pattern.Matches(p) }) {
+			return SequenceReplace
+		}
+		return SequenceAppend
+	})
+}
+
+type merger struct {
+	strategy func(p dyn.Path, a, b dyn.Value) SequenceStrategy
+}
+
 // Merge recursively merges the specified values.
 //
 // Semantics are as follows:
 // * Merging x with nil or nil with x always yields x.
 // * Merging maps a and b means entries from map b take precedence.
-// * Merging sequences a and b means concatenating them.
-func Merge(a, b dyn.Value) (dyn.Value, error) {
-	return merge(a, b)
+// * Merging sequences a and b means concatenating them,
+// unless [WithReplace] or [WithSequenceStrategy] configure sequence b to replace sequence a.
+func Merge(a, b dyn.Value, opts ...Option) (dyn.Value, error) {
+	m := &merger{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m.merge(dyn.EmptyPath, a, b)
 }
 
-func merge(a, b dyn.Value) (dyn.Value, error) {
+func (m *merger) merge(p dyn.Path, a, b dyn.Value) (dyn.Value, error) {
 	ak := a.Kind()
 	bk := b.Kind()
 
@@ -36,12 +82,12 @@ func merge(a, b dyn.Value) (dyn.Value, error) {
 		if bk != dyn.KindMap {
 			return dyn.NilValue, fmt.Errorf("cannot merge map with %s", bk)
 		}
-		return mergeMap(a, b)
+		return m.mergeMap(p, a, b)
 	case dyn.KindSequence:
 		if bk != dyn.KindSequence {
 			return dyn.NilValue, fmt.Errorf("cannot merge sequence with %s", bk)
 		}
-		return mergeSequence(a, b)
+		return m.mergeSequence(p, a, b)
 	default:
 		if ak != bk {
 			return dyn.NilValue, fmt.Errorf("cannot merge %s with %s", ak, bk)
@@ -50,7 +96,7 @@ func merge(a, b dyn.Value) (dyn.Value, error) {
 	}
 }
 
-func mergeMap(a, b dyn.Value) (dyn.Value, error) {
+func (m *merger) mergeMap(p dyn.Path, a, b dyn.Value) (dyn.Value, error) {
 	out := make(map[string]dyn.Value)
 	am := a.MustMap()
 	bm := b.MustMap()
@@ -64,7 +110,7 @@ func mergeMap(a, b dyn.Value) (dyn.Value, error) {
 	for k, v := range bm {
 		if _, ok := out[k]; ok {
 			// If the key already exists, merge the values.
-			merged, err := merge(out[k], v)
+			merged, err := m.merge(p.Append(dyn.Key(k)), out[k], v)
 			if err != nil {
 				return dyn.NilValue, err
 			}
@@ -79,7 +125,11 @@ func mergeMap(a, b dyn.Value) (dyn.Value, error) {
 	return dyn.NewValue(out, a.Location()), nil
 }
 
-func mergeSequence(a, b dyn.Value) (dyn.Value, error) {
+func (m *merger) mergeSequence(p dyn.Path, a, b dyn.Value) (dyn.Value, error) {
+	if m.strategy != nil && m.strategy(p, a, b) == SequenceReplace {
+		return b, nil
+	}
+
 	as := a.MustSequence()
 	bs := b.MustSequence()
 
@@ -116,6 +116,97 @@ func TestMergeSequences(t *testing.T) {
 	}
 }
 
+func TestMergeSequencesWithReplace(t *testing.T) {
+	v1 := dyn.V([]dyn.Value{
+		dyn.V("bar"),
+		dyn.V("baz"),
+	})
+
+	v2 := dyn.V([]dyn.Value{
+		dyn.V("qux"),
+		dyn.V("foo"),
+	})
+
+	// Merge v2 into v1.
+	{
+		out, err := Merge(v1, v2, WithReplace(dyn.NewPattern()))
+		assert.NoError(t, err)
+		assert.Equal(t, []any{
+			"qux",
+			"foo",
+		}, out.AsAny())
+	}
+
+	// Merge v1 into v2.
+	{
+		out, err := Merge(v2, v1, WithReplace(dyn.NewPattern()))
+		assert.NoError(t, err)
+		assert.Equal(t, []any{
+			"bar",
+			"baz",
+		}, out.AsAny())
+	}
+}
+
+func TestMergeNestedSequencesWithReplace(t *testing.T) {
+	v1 := dyn.V(map[string]dyn.Value{
+		"jobs": dyn.V(map[string]dyn.Value{
+			"foo": dyn.V(map[string]dyn.Value{
+				"tasks": dyn.V([]dyn.Value{dyn.V("a")}),
+				"tags":  dyn.V([]dyn.Value{dyn.V("x")}),
+			}),
+		}),
+	})
+
+	v2 := dyn.V(map[string]dyn.Value{
+		"jobs": dyn.V(map[string]dyn.Value{
+			"foo": dyn.V(map[string]dyn.Value{
+				"tasks": dyn.V([]dyn.Value{dyn.V("b")}),
+				"tags":  dyn.V([]dyn.Value{dyn.V("y")}),
+			}),
+		}),
+	})
+
+	out, err := Merge(v1, v2, WithReplace(dyn.NewPattern(dyn.Key("jobs"), dyn.AnyKey(), dyn.Key("tags"))))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"jobs": map[string]any{
+			"foo": map[string]any{
+				"tasks": []any{"a", "b"},
+				"tags":  []any{"y"},
+			},
+		},
+	}, out.AsAny())
+}
+
+func TestMergeSequencesWithStrategy(t *testing.T) {
+	v1 := dyn.V(map[string]dyn.Value{
+		"tasks": dyn.V([]dyn.Value{dyn.V("a")}),
+		"tags":  dyn.V([]dyn.Value{dyn.V("x")}),
+	})
+
+	v2 := dyn.V(map[string]dyn.Value{
+		"tasks": dyn.V([]dyn.Value{dyn.V("b")}),
+		"tags":  dyn.V([]dyn.Value{dyn.V("y")}),
+	})
+
+	// Replace sequences unless they are the tasks.
+	var paths []string
+	out, err := Merge(v1, v2, WithSequenceStrategy(func(p dyn.Path, a, b dyn.Value) SequenceStrategy {
+		paths = append(paths, p.String())
+		if p.String() == "tasks" {
+			return SequenceAppend
+		}
+		return SequenceReplace
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"tasks": []any{"a", "b"},
+		"tags":  []any{"y"},
+	}, out.AsAny())
+	assert.ElementsMatch(t, []string{"tasks", "tags"}, paths)
+}
+
 func TestMergeSequencesNil(t *testing.T) {
 	v := dyn.V([]dyn.Value{
 		dyn.V("bar"),
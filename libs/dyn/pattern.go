@@ -33,6 +33,34 @@ func NewPatternFromPath(p Path) Pattern {
 	return cs
 }
 
+// Matches returns true if the specified path matches this pattern.
+func (p Pattern) Matches(path Path) bool {
+	if len(p) != len(path) {
+		return false
+	}
+
+	for i, c := range p {
+		switch c := c.(type) {
+		case anyKeyComponent:
+			if !path[i].isKey() {
+				return false
+			}
+		case anyIndexComponent:
+			if !path[i].isIndex() {
+				return false
+			}
+		case pathComponent:
+			if c != path[i] {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 type anyKeyComponent struct{}
 
 // AnyKey returns a pattern component that matches any key.
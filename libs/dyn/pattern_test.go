@@ -26,3 +26,21 @@ func TestNewPatternFromPath(t *testing.T) {
 	pat2 := dyn.NewPatternFromPath(path)
 	assert.Equal(t, pat1, pat2)
 }
+
+func TestPatternMatches(t *testing.T) {
+	pat := dyn.NewPattern(
+		dyn.Key("resources"),
+		dyn.AnyKey(),
+		dyn.AnyKey(),
+		dyn.Key("tasks"),
+		dyn.AnyIndex(),
+	)
+
+	assert.True(t, pat.Matches(dyn.MustPathFromString("resources.jobs.foo.tasks[0]")))
+	assert.True(t, pat.Matches(dyn.MustPathFromString("resources.pipelines.bar.tasks[3]")))
+	assert.False(t, pat.Matches(dyn.MustPathFromString("resources.jobs.foo.tasks")))
+	assert.False(t, pat.Matches(dyn.MustPathFromString("resources.jobs.foo.tasks[0].libraries")))
+	assert.False(t, pat.Matches(dyn.MustPathFromString("resources.jobs.foo.clusters[0]")))
+	assert.False(t, pat.Matches(dyn.MustPathFromString("resources.jobs[0].foo.tasks[0]")))
+	assert.False(t, pat.Matches(dyn.MustPathFromString("resources.jobs.foo.tasks.bar")))
+}
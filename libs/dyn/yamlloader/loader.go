@@ -222,6 +222,11 @@ func (d *loader) loadScalar(node *yaml.Node, loc dyn.Location) (dyn.Value, error
 	}
 }
 
+// loadAlias loads the node an alias refers to.
+// The anchored node is loaded anew for every alias, such that every alias
+// expands into an independent copy of the anchored value. This is important
+// because mutators may modify values in place, and a modification through
+// one alias must not affect the anchor or any other aliases.
 func (d *loader) loadAlias(node *yaml.Node, loc dyn.Location) (dyn.Value, error) {
 	return d.load(node.Alias)
 }
@@ -115,3 +115,32 @@ func TestYAMLAnchor08(t *testing.T) {
 	assert.Equal(t, true, active.AsAny())
 	assert.Equal(t, dyn.Location{File: file, Line: 2, Column: 11}, active.Location())
 }
+
+func TestYAMLAnchorAliasesAreIndependentCopies(t *testing.T) {
+	file := "testdata/anchor_04.yml"
+	self := loadYAML(t, file)
+	assert.NotEqual(t, dyn.NilValue, self)
+
+	// Modify the map that the alias expanded to in place.
+	person1 := self.Get("person1").Get("address").MustMap()
+	person1["city"] = dyn.V("Oakland")
+
+	// The anchor itself must not be affected.
+	assert.Equal(t, "San Francisco", self.Get("address").Get("city").AsAny())
+	assert.Equal(t, "Oakland", self.Get("person1").Get("address").Get("city").AsAny())
+}
+
+func TestYAMLAnchorSequenceAliasesAreIndependentCopies(t *testing.T) {
+	file := "testdata/anchor_05.yml"
+	self := loadYAML(t, file)
+	assert.NotEqual(t, dyn.NilValue, self)
+
+	// Modify the sequence that the alias expanded to in place.
+	phone1 := self.Get("phone1").Get("features").MustSequence()
+	phone1[0] = dyn.V("nfc")
+
+	// The anchor and other aliases must not be affected.
+	assert.Equal(t, "wifi", self.Get("features").Index(0).AsAny())
+	assert.Equal(t, "wifi", self.Get("phone2").Get("features").Index(1).Index(0).AsAny())
+	assert.Equal(t, "nfc", self.Get("phone1").Get("features").Index(0).AsAny())
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/databricks/cli/libs/dyn"
 	"golang.org/x/exp/maps"
@@ -58,7 +59,12 @@ func (s *saver) SaveAsYAML(data any, filename string, force bool) error {
 }
 
 func (s *saver) encode(data any, w io.Writer) error {
-	yamlNode, err := s.toYamlNode(dyn.V(data))
+	return s.Encode(w, dyn.V(data))
+}
+
+// Encode writes the specified value to the writer as YAML.
+func (s *saver) Encode(w io.Writer, v dyn.Value) error {
+	yamlNode, err := s.toYamlNode(v)
 	if err != nil {
 		return err
 	}
@@ -76,6 +82,9 @@ func (s *saver) toYamlNodeWithStyle(v dyn.Value, style yaml.Style) (*yaml.Node,
 	case dyn.KindMap:
 		m, _ := v.AsMap()
 		keys := maps.Keys(m)
+		// Sort keys first such that keys at the same line (e.g. values
+		// without a location) are emitted in a deterministic order.
+		sort.Strings(keys)
 		// We're using location lines to define the order of keys in YAML.
 		// The location is set when we convert API response struct to config.Value representation
 		// See convert.convertMap for details
@@ -120,6 +129,10 @@ func (s *saver) toYamlNodeWithStyle(v dyn.Value, style yaml.Style) (*yaml.Node,
 		if isScalarValueInString(v) {
 			return &yaml.Node{Kind: yaml.ScalarNode, Value: v.MustString(), Style: yaml.DoubleQuotedStyle}, nil
 		}
+		// Use the literal block style for multiline strings (e.g. SQL queries) to preserve readability.
+		if style == yaml.Style(0) && strings.Contains(v.MustString(), "\n") {
+			return &yaml.Node{Kind: yaml.ScalarNode, Value: v.MustString(), Style: yaml.LiteralStyle}, nil
+		}
 		return &yaml.Node{Kind: yaml.ScalarNode, Value: v.MustString(), Style: style}, nil
 	case dyn.KindBool:
 		return &yaml.Node{Kind: yaml.ScalarNode, Value: fmt.Sprint(v.MustBool()), Style: style}, nil
@@ -1,6 +1,7 @@
 package yamlsaver
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -268,3 +269,26 @@ func TestCustomStylingWithNestedMap(t *testing.T) {
 	assert.Equal(t, yaml.Style(0), v.Content[2].Style)
 	assert.Equal(t, yaml.Style(0), v.Content[3].Style)
 }
+
+func TestMarshalMultilineStringUsesLiteralStyle(t *testing.T) {
+	s := NewSaver()
+	var v = dyn.NewValue("SELECT *\nFROM table\n", dyn.Location{})
+	n, err := s.toYamlNode(v)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT *\nFROM table\n", n.Value)
+	assert.Equal(t, yaml.LiteralStyle, n.Style)
+}
+
+func TestMarshalMapWithoutLocationsIsSorted(t *testing.T) {
+	s := NewSaver()
+	var v = dyn.V(map[string]dyn.Value{
+		"c": dyn.V("3"),
+		"a": dyn.V("1"),
+		"b": dyn.V("2"),
+	})
+
+	var buf bytes.Buffer
+	err := s.Encode(&buf, v)
+	assert.NoError(t, err)
+	assert.Equal(t, "a: \"1\"\nb: \"2\"\nc: \"3\"\n", buf.String())
+}
@@ -1,7 +1,10 @@
 package fileset
 
 import (
+	"fmt"
+	"path"
 	"path/filepath"
+	"strings"
 )
 
 func NewGlobSet(root string, includes []string) (*FileSet, error) {
@@ -20,3 +23,20 @@ func NewGlobSet(root string, includes []string) (*FileSet, error) {
 	}
 	return fs, nil
 }
+
+// ValidatePattern returns an error if the specified gitignore-style pattern is malformed.
+// Patterns may be negated with a leading "!" and may use "**" to match any number of directories.
+func ValidatePattern(pattern string) error {
+	p := strings.TrimPrefix(filepath.ToSlash(pattern), "!")
+	if strings.TrimSpace(p) == "" {
+		return fmt.Errorf("pattern %q is empty", pattern)
+	}
+
+	// The path package validates the complete pattern regardless of the
+	// input, so matching against the empty string surfaces syntax errors.
+	if _, err := path.Match(p, ""); err != nil {
+		return fmt.Errorf("pattern %q is invalid: %w", pattern, err)
+	}
+
+	return nil
+}
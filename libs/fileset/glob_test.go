@@ -157,3 +157,48 @@ func TestGlobFilesetDoubleQuotesWithFilePatterns(t *testing.T) {
 		require.True(t, exists)
 	}
 }
+
+func TestValidatePattern(t *testing.T) {
+	for _, pattern := range []string{
+		"*.go",
+		"./*.go",
+		"foo/bar/",
+		"foo/**/*.py",
+		"!foo/bar.py",
+		"[a-z]*.txt",
+	} {
+		require.NoError(t, ValidatePattern(pattern), pattern)
+	}
+
+	for _, pattern := range []string{
+		"",
+		"!",
+		"[a-z",
+		"foo/[",
+		"foo\\",
+	} {
+		require.Error(t, ValidatePattern(pattern), pattern)
+	}
+}
+
+func TestGlobFilesetMatchesPatterns(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"a.py", "b.txt", "foo/c.py", "foo/bar/d.py"} {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, nil, 0644))
+	}
+
+	g, err := NewGlobSet(root, []string{"foo/**/*.py"})
+	require.NoError(t, err)
+
+	files, err := g.All()
+	require.NoError(t, err)
+
+	var paths []string
+	for _, f := range files {
+		paths = append(paths, filepath.ToSlash(f.Relative))
+	}
+	slices.Sort(paths)
+	require.Equal(t, []string{"foo/bar/d.py", "foo/c.py"}, paths)
+}
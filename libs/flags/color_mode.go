@@ -0,0 +1,64 @@
+package flags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ColorMode controls whether the CLI produces colored output.
+type ColorMode string
+
+const (
+	// ColorAuto enables color if the output is a terminal and NO_COLOR is not set.
+	ColorAuto   ColorMode = "auto"
+	ColorAlways ColorMode = "always"
+	ColorNever  ColorMode = "never"
+)
+
+// colorModes lists the modes that users can choose from.
+var colorModes = []ColorMode{
+	ColorAuto,
+	ColorAlways,
+	ColorNever,
+}
+
+// ColorMode can be bound to a flag directly.
+var _ pflag.Value = (*ColorMode)(nil)
+
+func NewColorMode() ColorMode {
+	return ColorAuto
+}
+
+func (c *ColorMode) String() string {
+	return string(*c)
+}
+
+func (c *ColorMode) Set(s string) error {
+	v := ColorMode(strings.ToLower(s))
+	if !slices.Contains(colorModes, v) {
+		return fmt.Errorf("invalid color mode %q: must be one of %s", s, strings.Join(colorModeNames(), ", "))
+	}
+	*c = v
+	return nil
+}
+
+func (c *ColorMode) Type() string {
+	return "mode"
+}
+
+func colorModeNames() []string {
+	names := make([]string, len(colorModes))
+	for i, c := range colorModes {
+		names[i] = c.String()
+	}
+	return names
+}
+
+// Complete is the Cobra compatible completion function for this flag.
+func (c *ColorMode) Complete(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return colorModeNames(), cobra.ShellCompDirectiveNoFileComp
+}
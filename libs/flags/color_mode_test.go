@@ -0,0 +1,50 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorModeDefault(t *testing.T) {
+	c := NewColorMode()
+	assert.Equal(t, ColorAuto, c)
+}
+
+func TestColorModeSet(t *testing.T) {
+	c := NewColorMode()
+
+	err := c.Set("always")
+	assert.NoError(t, err)
+	assert.Equal(t, ColorAlways, c)
+
+	err = c.Set("NEVER")
+	assert.NoError(t, err)
+	assert.Equal(t, ColorNever, c)
+
+	err = c.Set("Auto")
+	assert.NoError(t, err)
+	assert.Equal(t, ColorAuto, c)
+
+	err = c.Set("sometimes")
+	assert.EqualError(t, err, `invalid color mode "sometimes": must be one of auto, always, never`)
+	assert.Equal(t, ColorAuto, c)
+}
+
+func TestColorModeFlag(t *testing.T) {
+	c := NewColorMode()
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(&c, "color", "when to use colored output")
+
+	err := fs.Parse([]string{"--color=never"})
+	assert.NoError(t, err)
+	assert.Equal(t, ColorNever, c)
+	assert.Equal(t, "mode", fs.Lookup("color").Value.Type())
+}
+
+func TestColorModeComplete(t *testing.T) {
+	c := NewColorMode()
+	values, _ := c.Complete(nil, nil, "")
+	assert.Equal(t, []string{"auto", "always", "never"}, values)
+}
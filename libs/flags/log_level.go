@@ -0,0 +1,62 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// LogLevel is the minimum level of the events that the progress logger emits.
+// Levels are ordered from least to most severe.
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+var logLevelNames = []string{
+	LogLevelDebug: "debug",
+	LogLevelInfo:  "info",
+	LogLevelWarn:  "warn",
+	LogLevelError: "error",
+}
+
+// LogLevel can be bound to a flag directly.
+var _ pflag.Value = (*LogLevel)(nil)
+
+func (l *LogLevel) String() string {
+	if *l < 0 || int(*l) >= len(logLevelNames) {
+		return "(unknown)"
+	}
+	return logLevelNames[*l]
+}
+
+func (l *LogLevel) Set(s string) error {
+	for i, name := range logLevelNames {
+		if strings.EqualFold(name, s) {
+			*l = LogLevel(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid log level %q: must be one of %s", s, strings.Join(logLevelNames, ", "))
+}
+
+func (l *LogLevel) Type() string {
+	return "level"
+}
+
+// Enabled returns true if an event at level other is emitted when the
+// minimum level is l.
+func (l LogLevel) Enabled(other LogLevel) bool {
+	return other >= l
+}
+
+// Complete is the Cobra compatible completion function for this flag.
+func (l *LogLevel) Complete(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return logLevelNames, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,56 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogLevelDefault(t *testing.T) {
+	var l LogLevel
+	assert.Equal(t, LogLevelDebug, l)
+	assert.Equal(t, "debug", l.String())
+}
+
+func TestLogLevelSet(t *testing.T) {
+	var l LogLevel
+
+	err := l.Set("warn")
+	assert.NoError(t, err)
+	assert.Equal(t, LogLevelWarn, l)
+
+	err = l.Set("ERROR")
+	assert.NoError(t, err)
+	assert.Equal(t, LogLevelError, l)
+
+	err = l.Set("Info")
+	assert.NoError(t, err)
+	assert.Equal(t, LogLevelInfo, l)
+
+	err = l.Set("trace")
+	assert.EqualError(t, err, `invalid log level "trace": must be one of debug, info, warn, error`)
+	assert.Equal(t, LogLevelInfo, l)
+}
+
+func TestLogLevelEnabled(t *testing.T) {
+	assert.True(t, LogLevelDebug.Enabled(LogLevelDebug))
+	assert.True(t, LogLevelDebug.Enabled(LogLevelError))
+	assert.True(t, LogLevelInfo.Enabled(LogLevelWarn))
+	assert.True(t, LogLevelWarn.Enabled(LogLevelWarn))
+	assert.False(t, LogLevelWarn.Enabled(LogLevelInfo))
+	assert.False(t, LogLevelError.Enabled(LogLevelDebug))
+}
+
+func TestLogLevelFlag(t *testing.T) {
+	var l LogLevel
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(&l, "level", "")
+
+	err := fs.Parse([]string{"--level", "error"})
+	assert.NoError(t, err)
+	assert.Equal(t, LogLevelError, l)
+
+	err = fs.Parse([]string{"--level", "loud"})
+	assert.ErrorContains(t, err, "must be one of debug, info, warn, error")
+}
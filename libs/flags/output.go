@@ -13,6 +13,7 @@ type Output string
 const (
 	OutputText Output = "text"
 	OutputJSON Output = "json"
+	OutputYAML Output = "yaml"
 )
 
 func (f *Output) String() string {
@@ -22,10 +23,10 @@ func (f *Output) String() string {
 func (f *Output) Set(s string) error {
 	lower := strings.ToLower(s)
 	switch lower {
-	case `json`, `text`:
+	case `json`, `text`, `yaml`:
 		*f = Output(lower)
 	default:
-		return fmt.Errorf("accepted arguments are json and text")
+		return fmt.Errorf("accepted arguments are json, text and yaml")
 	}
 	return nil
 }
@@ -39,5 +40,6 @@ func (f *Output) Complete(cmd *cobra.Command, args []string, toComplete string)
 	return []string{
 		fmt.Sprint(OutputText),
 		fmt.Sprint(OutputJSON),
+		fmt.Sprint(OutputYAML),
 	}, cobra.ShellCompDirectiveNoFileComp
 }
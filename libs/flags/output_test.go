@@ -12,7 +12,7 @@ func TestOutputFlag(t *testing.T) {
 
 	// Invalid
 	err = f.Set("foo")
-	assert.EqualError(t, err, "accepted arguments are json and text")
+	assert.EqualError(t, err, "accepted arguments are json, text and yaml")
 
 	// Lowercase
 	err = f.Set("text")
@@ -33,4 +33,14 @@ func TestOutputFlag(t *testing.T) {
 	err = f.Set("JSON")
 	assert.NoError(t, err)
 	assert.Equal(t, "json", f.String())
+
+	// Lowercase
+	err = f.Set("yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, "yaml", f.String())
+
+	// Uppercase
+	err = f.Set("YAML")
+	assert.NoError(t, err)
+	assert.Equal(t, "yaml", f.String())
 }
@@ -1,63 +1,120 @@
 package flags
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/databricks/cli/libs/env"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 type ProgressLogFormat string
 
 var (
+	ModeAuto    = ProgressLogFormat("auto")
 	ModeAppend  = ProgressLogFormat("append")
 	ModeInplace = ProgressLogFormat("inplace")
 	ModeJson    = ProgressLogFormat("json")
+	ModeNdjson  = ProgressLogFormat("ndjson")
+	ModeNone    = ProgressLogFormat("none")
 	ModeDefault = ProgressLogFormat("default")
 )
 
+// progressLogFormats lists the formats that users can choose from.
+// It is used for parsing, error messages, and completion.
+var progressLogFormats = []ProgressLogFormat{
+	ModeAuto,
+	ModeAppend,
+	ModeInplace,
+	ModeJson,
+	ModeNdjson,
+	ModeNone,
+}
+
+// ProgressLogFormat can be bound to a flag directly.
+var _ pflag.Value = (*ProgressLogFormat)(nil)
+
 func (p *ProgressLogFormat) String() string {
 	return string(*p)
 }
 
+// NewProgressLogFormat returns the default format.
+// The auto format is resolved to a concrete format when the logger is constructed.
 func NewProgressLogFormat() ProgressLogFormat {
-	return ModeDefault
+	return ModeAuto
+}
+
+// parseProgressLogFormat returns the format for the specified (case insensitive) string.
+func parseProgressLogFormat(s string) (ProgressLogFormat, error) {
+	p := ProgressLogFormat(strings.ToLower(s))
+
+	// We include ModeDefault here for symmetry reasons so this flag value
+	// can be unset after test runs. We should not point this value in error
+	// messages though since it's internal only
+	if p == ModeDefault {
+		return ModeJson, nil
+	}
+
+	if slices.Contains(progressLogFormats, p) {
+		return p, nil
+	}
+
+	return "", fmt.Errorf("invalid progress format %q: must be one of %s", s, strings.Join(progressLogFormatNames(), ", "))
+}
+
+// ProgressLogFormatFromEnv returns the format configured in the specified
+// environment variable, or the default format if it is not set.
+// It returns an error if the variable holds an invalid value.
+func ProgressLogFormatFromEnv(ctx context.Context, name string) (ProgressLogFormat, error) {
+	v, ok := env.Lookup(ctx, name)
+	if !ok {
+		return NewProgressLogFormat(), nil
+	}
+	p, err := parseProgressLogFormat(v)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	return p, nil
+}
+
+func progressLogFormatNames() []string {
+	names := make([]string, len(progressLogFormats))
+	for i, p := range progressLogFormats {
+		names[i] = p.String()
+	}
+	return names
 }
 
 func (p *ProgressLogFormat) Set(s string) error {
-	lower := strings.ToLower(s)
-	switch lower {
-	case ModeAppend.String():
-		*p = ProgressLogFormat(ModeAppend.String())
-	case ModeInplace.String():
-		*p = ProgressLogFormat(ModeInplace.String())
-	case ModeJson.String():
-		*p = ProgressLogFormat(ModeJson.String())
-	case ModeDefault.String():
-		// We include ModeDefault here for symmetry reasons so this flag value
-		// can be unset after test runs. We should not point this value in error
-		// messages though since it's internal only
-		*p = ProgressLogFormat(ModeJson.String())
-	default:
-		valid := []string{
-			ModeAppend.String(),
-			ModeInplace.String(),
-			ModeJson.String(),
-		}
-		return fmt.Errorf("accepted arguments are [%s]", strings.Join(valid, ", "))
+	v, err := parseProgressLogFormat(s)
+	if err != nil {
+		return err
 	}
+	*p = v
 	return nil
 }
 
+func (p ProgressLogFormat) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(p))
+}
+
+func (p *ProgressLogFormat) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return p.Set(s)
+}
+
 func (p *ProgressLogFormat) Type() string {
 	return "format"
 }
 
 // Complete is the Cobra compatible completion function for this flag.
 func (f *ProgressLogFormat) Complete(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	return []string{
-		"append",
-		"inplace",
-		"json",
-	}, cobra.ShellCompDirectiveNoFileComp
+	return progressLogFormatNames(), cobra.ShellCompDirectiveNoFileComp
 }
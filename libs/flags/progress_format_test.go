@@ -1,14 +1,18 @@
 package flags
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/databricks/cli/libs/env"
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestProgressFormatNonTtyDefault(t *testing.T) {
 	format := NewProgressLogFormat()
-	assert.Equal(t, format, ModeDefault)
+	assert.Equal(t, format, ModeAuto)
 }
 
 func TestProgressFormatSet(t *testing.T) {
@@ -16,7 +20,7 @@ func TestProgressFormatSet(t *testing.T) {
 
 	// invalid arg
 	err := p.Set("foo")
-	assert.ErrorContains(t, err, "accepted arguments are [append, inplace, json]")
+	assert.EqualError(t, err, `invalid progress format "foo": must be one of auto, append, inplace, json, ndjson, none`)
 
 	// set json
 	err = p.Set("json")
@@ -31,6 +35,15 @@ func TestProgressFormatSet(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "json", p.String())
 
+	// set ndjson
+	err = p.Set("ndjson")
+	assert.NoError(t, err)
+	assert.Equal(t, ModeNdjson, p)
+
+	err = p.Set("NDJSON")
+	assert.NoError(t, err)
+	assert.Equal(t, ModeNdjson, p)
+
 	// set append
 	err = p.Set("append")
 	assert.NoError(t, err)
@@ -56,4 +69,106 @@ func TestProgressFormatSet(t *testing.T) {
 	err = p.Set("INPLACE")
 	assert.NoError(t, err)
 	assert.Equal(t, "inplace", p.String())
+
+	// set auto
+	err = p.Set("auto")
+	assert.NoError(t, err)
+	assert.Equal(t, "auto", p.String())
+
+	err = p.Set("AUTO")
+	assert.NoError(t, err)
+	assert.Equal(t, "auto", p.String())
+
+	// set none
+	err = p.Set("none")
+	assert.NoError(t, err)
+	assert.Equal(t, "none", p.String())
+
+	err = p.Set("NONE")
+	assert.NoError(t, err)
+	assert.Equal(t, "none", p.String())
+}
+
+func TestProgressFormatSetDefault(t *testing.T) {
+	// Setting "default" selects the JSON format, as it always has.
+	p := ModeAppend
+	err := p.Set("default")
+	assert.NoError(t, err)
+	assert.Equal(t, ModeJson, p)
+}
+
+func TestProgressFormatFlag(t *testing.T) {
+	p := NewProgressLogFormat()
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(&p, "progress-format", "format for progress logs")
+
+	err := fs.Parse([]string{"--progress-format=append"})
+	assert.NoError(t, err)
+	assert.Equal(t, ModeAppend, p)
+	assert.Equal(t, "format", fs.Lookup("progress-format").Value.Type())
+
+	// Invalid values are rejected when parsing flags.
+	err = fs.Parse([]string{"--progress-format=foo"})
+	assert.ErrorContains(t, err, `invalid progress format "foo"`)
+	assert.Equal(t, ModeAppend, p)
+}
+
+func TestProgressFormatComplete(t *testing.T) {
+	p := NewProgressLogFormat()
+	values, _ := p.Complete(nil, nil, "")
+	assert.Equal(t, []string{"auto", "append", "inplace", "json", "ndjson", "none"}, values)
+}
+
+func TestProgressFormatRoundTrip(t *testing.T) {
+	for _, format := range progressLogFormats {
+		var p ProgressLogFormat
+		err := p.Set(format.String())
+		assert.NoError(t, err)
+		assert.Equal(t, format, p)
+	}
+}
+
+func TestProgressFormatFromEnvUnset(t *testing.T) {
+	p, err := ProgressLogFormatFromEnv(context.Background(), "TEST_PROGRESS_FORMAT")
+	assert.NoError(t, err)
+	assert.Equal(t, ModeAuto, p)
+}
+
+func TestProgressFormatFromEnvSet(t *testing.T) {
+	ctx := env.Set(context.Background(), "TEST_PROGRESS_FORMAT", "JSON")
+	p, err := ProgressLogFormatFromEnv(ctx, "TEST_PROGRESS_FORMAT")
+	assert.NoError(t, err)
+	assert.Equal(t, ModeJson, p)
+}
+
+func TestProgressFormatFromEnvInvalid(t *testing.T) {
+	ctx := env.Set(context.Background(), "TEST_PROGRESS_FORMAT", "foo")
+	_, err := ProgressLogFormatFromEnv(ctx, "TEST_PROGRESS_FORMAT")
+	assert.EqualError(t, err, `TEST_PROGRESS_FORMAT: invalid progress format "foo": must be one of auto, append, inplace, json, ndjson, none`)
+}
+
+func TestProgressFormatJSONRoundTrip(t *testing.T) {
+	type config struct {
+		Format ProgressLogFormat `json:"format"`
+	}
+
+	for _, format := range progressLogFormats {
+		b, err := json.Marshal(config{Format: format})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"format":"`+format.String()+`"}`, string(b))
+
+		var out config
+		err = json.Unmarshal(b, &out)
+		assert.NoError(t, err)
+		assert.Equal(t, format, out.Format)
+	}
+}
+
+func TestProgressFormatUnmarshalJSONInvalid(t *testing.T) {
+	var p ProgressLogFormat
+	err := json.Unmarshal([]byte(`"foo"`), &p)
+	assert.ErrorContains(t, err, `invalid progress format "foo"`)
+
+	err = json.Unmarshal([]byte(`1`), &p)
+	assert.Error(t, err)
 }
@@ -1,4 +1,4 @@
-package repos
+package git
 
 import (
 	"net/url"
@@ -15,6 +15,8 @@ var gitProviders = map[string]string{
 
 var awsCodeCommitRegexp = regexp.MustCompile(`^git-codecommit\.[^.]+\.amazonaws.com$`)
 
+// DetectProvider returns the Git provider of the repository at the given URL in the form
+// the Repos API expects, or an empty string if the provider cannot be detected.
 func DetectProvider(rawURL string) string {
 	provider := ""
 	u, err := url.Parse(rawURL)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"slices"
+	"strings"
 )
 
 // Load a JSON document and validate it against the JSON schema. Instance here
@@ -152,11 +153,33 @@ func (s *Schema) validateAnyOf(instance map[string]any) error {
 		return fmt.Errorf("anyOf must contain at least one schema")
 	}
 
-	for _, anyOf := range s.AnyOf {
+	errs := make([]error, len(s.AnyOf))
+	for i, anyOf := range s.AnyOf {
 		err := anyOf.ValidateInstance(instance)
 		if err == nil {
 			return nil
 		}
+		errs[i] = err
 	}
-	return fmt.Errorf("instance does not match any of the schemas in anyOf")
+	return &AnyOfError{Errors: errs}
+}
+
+// AnyOfError is returned if an instance does not match any of the schemas in anyOf.
+// It includes the reason each schema did not match, indexed by the position of the
+// schema in anyOf, so that template authors can see which schema they almost satisfied.
+type AnyOfError struct {
+	Errors []error
+}
+
+func (e *AnyOfError) Error() string {
+	var b strings.Builder
+	b.WriteString("instance does not match any of the schemas in anyOf")
+	for i, err := range e.Errors {
+		fmt.Fprintf(&b, "\n  - anyOf[%d]: %s", i, err)
+	}
+	return b.String()
+}
+
+func (e *AnyOfError) Unwrap() []error {
+	return e.Errors
 }
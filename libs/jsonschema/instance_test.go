@@ -315,13 +315,26 @@ func TestValidateInstanceForAnyOf(t *testing.T) {
 		"foo": "xyz",
 		"bar": "abc",
 	}
-	assert.EqualError(t, schema.validateAnyOf(invalidInstanceValue), "instance does not match any of the schemas in anyOf")
-	assert.EqualError(t, schema.ValidateInstance(invalidInstanceValue), "instance does not match any of the schemas in anyOf")
+	expected := "instance does not match any of the schemas in anyOf\n" +
+		"  - anyOf[0]: expected value of property foo to be abc. Found: xyz\n" +
+		"  - anyOf[1]: expected value of property bar to be def. Found: abc"
+	assert.EqualError(t, schema.validateAnyOf(invalidInstanceValue), expected)
+	assert.EqualError(t, schema.ValidateInstance(invalidInstanceValue), expected)
 
 	// Invalid value for both
 	invalidInstanceValue = map[string]any{
 		"bar": "xyz",
 	}
-	assert.EqualError(t, schema.validateAnyOf(invalidInstanceValue), "instance does not match any of the schemas in anyOf")
-	assert.EqualError(t, schema.ValidateInstance(invalidInstanceValue), "instance does not match any of the schemas in anyOf")
+	expected = "instance does not match any of the schemas in anyOf\n" +
+		"  - anyOf[0]: no value provided for required property foo\n" +
+		"  - anyOf[1]: no value provided for required property foo"
+	assert.EqualError(t, schema.validateAnyOf(invalidInstanceValue), expected)
+	assert.EqualError(t, schema.ValidateInstance(invalidInstanceValue), expected)
+
+	// The error includes the reason for each schema in anyOf.
+	var anyOfErr *AnyOfError
+	require.ErrorAs(t, schema.ValidateInstance(invalidInstanceValue), &anyOfErr)
+	require.Len(t, anyOfErr.Errors, 2)
+	assert.EqualError(t, anyOfErr.Errors[0], "no value provided for required property foo")
+	assert.EqualError(t, anyOfErr.Errors[1], "no value provided for required property foo")
 }
@@ -0,0 +1,136 @@
+package jsonschema
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Markdown writes a reference of all properties in the schema to the writer.
+//
+// Every object in the schema is rendered as a section with a table of its
+// properties, including their type, description, and default value. Sections
+// are named after the path of the object, where `<name>` stands for the key
+// of a map entry and `[]` for an element of a sequence. Properties are ordered
+// according to [Schema.OrderedProperties] such that the output is stable.
+func (s *Schema) Markdown(w io.Writer, title string) error {
+	_, err := fmt.Fprintf(w, "# %s\n", title)
+	if err != nil {
+		return err
+	}
+	return s.markdownSection(w, "")
+}
+
+func (s *Schema) markdownSection(w io.Writer, path string) error {
+	// Skip properties without a schema (e.g. fields of an interface type).
+	filtered := &Schema{Properties: make(map[string]*Schema)}
+	for k, v := range s.Properties {
+		if v != nil {
+			filtered.Properties[k] = v
+		}
+	}
+
+	props := filtered.OrderedProperties()
+	if len(props) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	if path != "" {
+		fmt.Fprintf(&b, "\n## %s\n", markdownHeading(path))
+	}
+	if path != "" && s.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", markdownText(s.Description))
+	}
+	b.WriteString("\n| Key | Type | Description | Default |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+	for _, p := range props {
+		def := ""
+		if p.Schema.Default != nil {
+			def = fmt.Sprintf("`%v`", p.Schema.Default)
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %s |\n", p.Name, markdownType(p.Schema), markdownText(p.Schema.Description), def)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	if err != nil {
+		return err
+	}
+
+	// Render sections for nested objects.
+	for _, p := range props {
+		child, childPath := nestedObject(p.Schema, joinPath(path, p.Name))
+		if child == nil {
+			continue
+		}
+		err := child.markdownSection(w, childPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nestedObject returns the schema of the object nested in the specified schema,
+// if any, and its path. It looks through maps and sequences.
+func nestedObject(s *Schema, path string) (*Schema, string) {
+	for {
+		switch {
+		case len(s.Properties) > 0:
+			return s, path
+		case s.Type == ArrayType && s.Items != nil:
+			s = s.Items
+			path += "[]"
+		case s.Type == ObjectType && s.AdditionalProperties != nil:
+			ap, ok := s.AdditionalProperties.(*Schema)
+			if !ok {
+				return nil, ""
+			}
+			s = ap
+			path += ".<name>"
+		default:
+			return nil, ""
+		}
+	}
+}
+
+func markdownType(s *Schema) string {
+	switch {
+	case s.Type == ArrayType && s.Items != nil:
+		return "Sequence of " + markdownType(s.Items)
+	case s.Type == ObjectType && len(s.Properties) == 0:
+		if ap, ok := s.AdditionalProperties.(*Schema); ok {
+			return "Map of " + markdownType(ap)
+		}
+		return "Map"
+	case s.Type == ObjectType:
+		return "Object"
+	case s.Type != "":
+		return string(s.Type)
+	default:
+		return "any"
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// markdownHeading escapes the angle brackets in a section path such that
+// placeholders like `<name>` are not interpreted as raw HTML.
+func markdownHeading(s string) string {
+	s = strings.ReplaceAll(s, "<", "\\<")
+	s = strings.ReplaceAll(s, ">", "\\>")
+	return s
+}
+
+// markdownText makes the text safe for use in a single line of a Markdown table.
+func markdownText(s string) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,64 @@
+package jsonschema
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaMarkdown(t *testing.T) {
+	s := &Schema{
+		Type: ObjectType,
+		Properties: map[string]*Schema{
+			"name": {
+				Type:        StringType,
+				Description: "Name of the thing.",
+				Default:     "foo",
+			},
+			"settings": {
+				Type:        ObjectType,
+				Description: "Settings\nthat span | lines.",
+				Properties: map[string]*Schema{
+					"enabled": {Type: BooleanType},
+					"count":   {Type: IntegerType, Description: "Number of things."},
+				},
+			},
+			"items": {
+				Type: ObjectType,
+				AdditionalProperties: &Schema{
+					Type: ObjectType,
+					Properties: map[string]*Schema{
+						"values": {
+							Type:  ArrayType,
+							Items: &Schema{Type: StringType},
+						},
+						"children": {
+							Type: ArrayType,
+							Items: &Schema{
+								Type: ObjectType,
+								Properties: map[string]*Schema{
+									"key": {Type: StringType},
+								},
+							},
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:                 ObjectType,
+				AdditionalProperties: &Schema{Type: StringType},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := s.Markdown(&buf, "Reference")
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile("testdata/markdown/golden.md")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), buf.String())
+}
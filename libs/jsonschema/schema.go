@@ -1,11 +1,13 @@
 package jsonschema
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
 	"slices"
+	"strings"
 
 	"github.com/databricks/cli/internal/build"
 	"golang.org/x/mod/semver"
@@ -13,6 +15,15 @@ import (
 
 // defines schema for a json object
 type Schema struct {
+	// Identifier of the schema.
+	ID string `json:"$id,omitempty"`
+
+	// URI of the meta-schema (i.e. dialect) this schema is written in.
+	SchemaURI string `json:"$schema,omitempty"`
+
+	// Comment for schema authors. It is not used for validation.
+	Comment string `json:"$comment,omitempty"`
+
 	// Type of the object
 	Type Type `json:"type,omitempty"`
 
@@ -118,6 +129,13 @@ func (schema *Schema) validateSchemaDefaultValueTypes() error {
 		if property.Default == nil {
 			continue
 		}
+		// Integers are valid numbers. Coerce them so that the default value
+		// has the same type as numbers decoded from JSON.
+		if property.Type == NumberType {
+			if f, ok := integerToFloat(property.Default); ok {
+				property.Default = f
+			}
+		}
 		if err := validateType(property.Default, property.Type); err != nil {
 			return fmt.Errorf("type validation for default value of property %s failed: %w", name, err)
 		}
@@ -153,6 +171,15 @@ func (schema *Schema) validateSchemaEnumValueTypes() error {
 	return nil
 }
 
+// joinValues formats values as a comma separated list.
+func joinValues(values []any) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = fmt.Sprint(v)
+	}
+	return strings.Join(s, ", ")
+}
+
 // Validate default value is contained in the list of enums if both are defined.
 func (schema *Schema) validateSchemaDefaultValueIsInEnums() error {
 	for name, property := range schema.Properties {
@@ -162,7 +189,7 @@ func (schema *Schema) validateSchemaDefaultValueIsInEnums() error {
 		// We expect the default value to be consistent with the list of enum
 		// values.
 		if !slices.Contains(property.Enum, property.Default) {
-			return fmt.Errorf("list of enum values for property %s does not contain default value %v: %v", name, property.Default, property.Enum)
+			return fmt.Errorf("default value %#v for property %s is not in enum [%s]", property.Default, name, joinValues(property.Enum))
 		}
 	}
 	return nil
@@ -251,12 +278,27 @@ func (schema *Schema) validate() error {
 }
 
 func Load(path string) (*Schema, error) {
+	return load(path, false)
+}
+
+// LoadStrict loads a schema like [Load] but rejects keywords that are not
+// known to this package, such as misspelled keywords. The $id, $schema and
+// $comment annotations are accepted.
+func LoadStrict(path string) (*Schema, error) {
+	return load(path, true)
+}
+
+func load(path string, strict bool) (*Schema, error) {
 	b, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 	schema := &Schema{}
-	err = json.Unmarshal(b, schema)
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+	err = dec.Decode(schema)
 	if err != nil {
 		return nil, err
 	}
@@ -1,9 +1,11 @@
 package jsonschema
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSchemaValidateTypeNames(t *testing.T) {
@@ -92,6 +94,21 @@ func TestSchemaValidateDefaultType(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSchemaValidateIntegerDefaultForNumberType(t *testing.T) {
+	schema := &Schema{
+		Properties: map[string]*Schema{
+			"foo": {
+				Type:    "number",
+				Default: 1,
+			},
+		},
+	}
+
+	err := schema.validate()
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), schema.Properties["foo"].Default)
+}
+
 func TestSchemaValidateEnumType(t *testing.T) {
 	invalidSchema := &Schema{
 		Properties: map[string]*Schema{
@@ -130,7 +147,7 @@ func TestSchemaValidateErrorWhenDefaultValueIsNotInEnums(t *testing.T) {
 	}
 
 	err := invalidSchema.validate()
-	assert.EqualError(t, err, "list of enum values for property foo does not contain default value abc: [def ghi]")
+	assert.EqualError(t, err, `default value "abc" for property foo is not in enum [def, ghi]`)
 
 	validSchema := &Schema{
 		Properties: map[string]*Schema{
@@ -146,6 +163,25 @@ func TestSchemaValidateErrorWhenDefaultValueIsNotInEnums(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSchemaValidateIntegerDefaultValueIsInEnums(t *testing.T) {
+	s := &Schema{
+		Properties: map[string]*Schema{
+			"foo": {
+				Type:    "integer",
+				Default: int64(4),
+				Enum:    []any{int64(1), int64(2), int64(3)},
+			},
+		},
+	}
+
+	err := s.validate()
+	assert.EqualError(t, err, "default value 4 for property foo is not in enum [1, 2, 3]")
+
+	s.Properties["foo"].Default = int64(2)
+	err = s.validate()
+	assert.NoError(t, err)
+}
+
 func TestSchemaValidatePatternType(t *testing.T) {
 	s := &Schema{
 		Properties: map[string]*Schema{
@@ -305,3 +341,32 @@ func TestValidateSchemaSkippedPropertiesHaveDefaults(t *testing.T) {
 	err = s.validate()
 	assert.NoError(t, err)
 }
+
+func TestSchemaLoadStrictAcceptsAnnotations(t *testing.T) {
+	schema, err := LoadStrict("./testdata/schema-load-strict/schema-valid.json")
+	require.NoError(t, err)
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema.SchemaURI)
+	assert.Equal(t, "https://example.com/template.schema.json", schema.ID)
+	assert.Equal(t, "Properties for the template.", schema.Comment)
+	assert.Equal(t, "Used as the project name.", schema.Properties["abc"].Comment)
+
+	// Annotations round-trip through marshaling.
+	b, err := json.Marshal(schema)
+	require.NoError(t, err)
+	var out Schema
+	err = json.Unmarshal(b, &out)
+	require.NoError(t, err)
+	assert.Equal(t, schema.Comment, out.Comment)
+	assert.Equal(t, schema.ID, out.ID)
+	assert.Equal(t, schema.SchemaURI, out.SchemaURI)
+	assert.Equal(t, schema.Properties["abc"].Comment, out.Properties["abc"].Comment)
+}
+
+func TestSchemaLoadStrictRejectsUnknownKeywords(t *testing.T) {
+	_, err := LoadStrict("./testdata/schema-load-strict/schema-misspelled.json")
+	assert.EqualError(t, err, `json: unknown field "defualt"`)
+
+	// Non-strict loading ignores unknown keywords.
+	_, err = Load("./testdata/schema-load-strict/schema-misspelled.json")
+	assert.NoError(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"os"
 	"slices"
 	"time"
 
@@ -70,6 +71,16 @@ type LockState struct {
 	IsForced bool
 	// creator of this locker
 	User string
+	// name of the machine the locker was created on
+	Host string
+}
+
+// Holder describes who holds the lock, for use in messages.
+func (s *LockState) Holder() string {
+	if s.Host == "" {
+		return s.User
+	}
+	return fmt.Sprintf("%s on %s", s.User, s.Host)
 }
 
 // GetActiveLockState returns current lock state, irrespective of us holding it.
@@ -105,10 +116,10 @@ func (locker *Locker) assertLockHeld(ctx context.Context) error {
 		return err
 	}
 	if activeLockState.ID != locker.State.ID && !activeLockState.IsForced {
-		return fmt.Errorf("deploy lock acquired by %s at %v. Use --force-lock to override", activeLockState.User, activeLockState.AcquisitionTime)
+		return fmt.Errorf("deploy lock acquired by %s at %v. Use --force-lock to override", activeLockState.Holder(), activeLockState.AcquisitionTime)
 	}
 	if activeLockState.ID != locker.State.ID && activeLockState.IsForced {
-		return fmt.Errorf("deploy lock force acquired by %s at %v. Use --force-lock to override", activeLockState.User, activeLockState.AcquisitionTime)
+		return fmt.Errorf("deploy lock force acquired by %s at %v. Use --force-lock to override", activeLockState.Holder(), activeLockState.AcquisitionTime)
 	}
 	return nil
 }
@@ -134,6 +145,7 @@ func (locker *Locker) Lock(ctx context.Context, isForced bool) error {
 		AcquisitionTime: time.Now(),
 		IsForced:        isForced,
 		User:            locker.State.User,
+		Host:            locker.State.Host,
 	}
 	buf, err := json.Marshal(newLockerState)
 	if err != nil {
@@ -196,6 +208,27 @@ func (locker *Locker) Unlock(ctx context.Context, opts ...UnlockOption) error {
 	return nil
 }
 
+// ForceUnlock removes the lock file irrespective of who holds the lock.
+// It returns the state of the lock that was removed, or an error wrapping
+// [fs.ErrNotExist] if there is no active lock.
+//
+// This is meant to recover from deployments that failed to release their lock,
+// for example because the process was killed.
+func (locker *Locker) ForceUnlock(ctx context.Context) (*LockState, error) {
+	state, err := locker.GetActiveLockState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = locker.filer.Delete(ctx, LockFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	locker.Active = false
+	return state, nil
+}
+
 func CreateLocker(user string, targetDir string, w *databricks.WorkspaceClient) (*Locker, error) {
 	filer, err := filer.NewWorkspaceFilesClient(w, targetDir)
 	if err != nil {
@@ -210,8 +243,18 @@ func CreateLocker(user string, targetDir string, w *databricks.WorkspaceClient)
 		State: &LockState{
 			ID:   uuid.New(),
 			User: user,
+			Host: hostname(),
 		},
 	}
 
 	return locker, nil
 }
+
+// hostname returns the name of this machine, or an empty string if it cannot be determined.
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,118 @@
+package locker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"testing"
+	"time"
+
+	mockfiler "github.com/databricks/cli/internal/mocks/libs/filer"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func lockFileReader(t *testing.T, state LockState) io.ReadCloser {
+	buf, err := json.Marshal(state)
+	require.NoError(t, err)
+	return io.NopCloser(bytes.NewReader(buf))
+}
+
+func TestLockHeldByOther(t *testing.T) {
+	other := LockState{
+		ID:              uuid.New(),
+		AcquisitionTime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		User:            "someone@example.com",
+		Host:            "ci-runner-1",
+	}
+
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Write(mock.Anything, LockFileName, mock.Anything, filer.CreateParentDirectories).
+		Return(filer.FileAlreadyExistsError{})
+	f.EXPECT().
+		Read(mock.Anything, LockFileName).
+		Return(lockFileReader(t, other), nil)
+
+	locker := &Locker{
+		filer: f,
+		State: &LockState{ID: uuid.New(), User: "me@example.com", Host: "laptop"},
+	}
+
+	err := locker.Lock(context.Background(), false)
+	assert.EqualError(t, err, "deploy lock acquired by someone@example.com on ci-runner-1 at 2024-01-02 03:04:05 +0000 UTC. Use --force-lock to override")
+	assert.False(t, locker.Active)
+}
+
+func TestLockRecordsHost(t *testing.T) {
+	id := uuid.New()
+
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Write(mock.Anything, LockFileName, mock.Anything, filer.CreateParentDirectories).
+		RunAndReturn(func(ctx context.Context, path string, r io.Reader, mode ...filer.WriteMode) error {
+			var state LockState
+			require.NoError(t, json.NewDecoder(r).Decode(&state))
+			assert.Equal(t, "laptop", state.Host)
+			return nil
+		})
+	f.EXPECT().
+		Read(mock.Anything, LockFileName).
+		Return(lockFileReader(t, LockState{ID: id}), nil)
+
+	locker := &Locker{
+		filer: f,
+		State: &LockState{ID: id, User: "me@example.com", Host: "laptop"},
+	}
+
+	err := locker.Lock(context.Background(), false)
+	require.NoError(t, err)
+	assert.True(t, locker.Active)
+	assert.Equal(t, "laptop", locker.State.Host)
+}
+
+func TestForceUnlock(t *testing.T) {
+	other := LockState{ID: uuid.New(), User: "someone@example.com", Host: "ci-runner-1"}
+
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, LockFileName).
+		Return(lockFileReader(t, other), nil)
+	f.EXPECT().
+		Delete(mock.Anything, LockFileName).
+		Return(nil)
+
+	locker := &Locker{
+		filer: f,
+		State: &LockState{ID: uuid.New(), User: "me@example.com"},
+	}
+
+	state, err := locker.ForceUnlock(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "someone@example.com on ci-runner-1", state.Holder())
+}
+
+func TestForceUnlockWithoutLock(t *testing.T) {
+	f := mockfiler.NewMockFiler(t)
+	f.EXPECT().
+		Read(mock.Anything, LockFileName).
+		Return(nil, fs.ErrNotExist)
+
+	locker := &Locker{
+		filer: f,
+		State: &LockState{ID: uuid.New(), User: "me@example.com"},
+	}
+
+	_, err := locker.ForceUnlock(context.Background())
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestLockStateHolder(t *testing.T) {
+	assert.Equal(t, "someone@example.com", (&LockState{User: "someone@example.com"}).Holder())
+	assert.Equal(t, "someone@example.com on laptop", (&LockState{User: "someone@example.com", Host: "laptop"}).Holder())
+}
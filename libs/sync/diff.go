@@ -61,11 +61,18 @@ func (d *diff) addFilesWithRemoteNameChanged(after *SnapshotState, before *Snaps
 }
 
 // Add operators for files that were not being tracked before.
+// Files with a known content hash that is unchanged are skipped; this is the case
+// if the hashes were recorded by a sync from another snapshot.
 func (d *diff) addNewFiles(after *SnapshotState, before *SnapshotState) {
 	for localName := range after.LastModifiedTimes {
-		if _, ok := before.LastModifiedTimes[localName]; !ok {
-			d.put = append(d.put, filepath.ToSlash(localName))
+		if _, ok := before.LastModifiedTimes[localName]; ok {
+			continue
 		}
+		prevHash, ok := before.ContentHashes[localName]
+		if ok && prevHash == after.ContentHashes[localName] {
+			continue
+		}
+		d.put = append(d.put, filepath.ToSlash(localName))
 	}
 
 	// Add directories required for these new files.
@@ -75,12 +82,18 @@ func (d *diff) addNewFiles(after *SnapshotState, before *SnapshotState) {
 }
 
 // Add operators for files which had their contents updated.
+// Files that were touched but have the same content hash as before are skipped.
 func (d *diff) addUpdatedFiles(after *SnapshotState, before *SnapshotState) {
 	for localName, modTime := range after.LastModifiedTimes {
 		prevModTime, ok := before.LastModifiedTimes[localName]
-		if ok && modTime.After(prevModTime) {
-			d.put = append(d.put, filepath.ToSlash(localName))
+		if !ok || !modTime.After(prevModTime) {
+			continue
+		}
+		prevHash, ok := before.ContentHashes[localName]
+		if ok && prevHash == after.ContentHashes[localName] {
+			continue
 		}
+		d.put = append(d.put, filepath.ToSlash(localName))
 	}
 }
 
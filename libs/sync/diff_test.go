@@ -150,6 +150,41 @@ func TestDiffComputationForNewFiles(t *testing.T) {
 	assert.Equal(t, expected, computeDiff(after, &SnapshotState{}))
 }
 
+func TestDiffComputationForNewFilesWithKnownContent(t *testing.T) {
+	after := &SnapshotState{
+		LocalToRemoteNames: map[string]string{
+			"a": "a",
+			"b": "b",
+		},
+		RemoteToLocalNames: map[string]string{
+			"a": "a",
+			"b": "b",
+		},
+		LastModifiedTimes: map[string]time.Time{
+			"a": time.Now(),
+			"b": time.Now(),
+		},
+		ContentHashes: map[string]string{
+			"a": "hash-a",
+			"b": "hash-b-modified",
+		},
+	}
+	before := &SnapshotState{
+		ContentHashes: map[string]string{
+			"a": "hash-a",
+			"b": "hash-b",
+		},
+	}
+
+	expected := diff{
+		delete: []string{},
+		rmdir:  []string{},
+		mkdir:  []string{},
+		put:    []string{"b"},
+	}
+	assert.Equal(t, expected, computeDiff(after, before))
+}
+
 func TestDiffComputationForUpdatedFiles(t *testing.T) {
 	tick := time.Now()
 	before := &SnapshotState{
@@ -184,3 +219,52 @@ func TestDiffComputationForUpdatedFiles(t *testing.T) {
 	}
 	assert.Equal(t, expected, computeDiff(after, before))
 }
+
+func TestDiffComputationForUpdatedFilesWithUnchangedContent(t *testing.T) {
+	tick := time.Now()
+	before := &SnapshotState{
+		LocalToRemoteNames: map[string]string{
+			"a": "a",
+			"b": "b",
+		},
+		RemoteToLocalNames: map[string]string{
+			"a": "a",
+			"b": "b",
+		},
+		LastModifiedTimes: map[string]time.Time{
+			"a": tick,
+			"b": tick,
+		},
+		ContentHashes: map[string]string{
+			"a": "hash-a",
+			"b": "hash-b",
+		},
+	}
+	tick = tick.Add(time.Second)
+	after := &SnapshotState{
+		LocalToRemoteNames: map[string]string{
+			"a": "a",
+			"b": "b",
+		},
+		RemoteToLocalNames: map[string]string{
+			"a": "a",
+			"b": "b",
+		},
+		LastModifiedTimes: map[string]time.Time{
+			"a": tick,
+			"b": tick,
+		},
+		ContentHashes: map[string]string{
+			"a": "hash-a",
+			"b": "hash-b-modified",
+		},
+	}
+
+	expected := diff{
+		delete: []string{},
+		rmdir:  []string{},
+		mkdir:  []string{},
+		put:    []string{"b"},
+	}
+	assert.Equal(t, expected, computeDiff(after, before))
+}
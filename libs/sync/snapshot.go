@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"time"
@@ -38,6 +39,14 @@ type Snapshot struct {
 	// New indicates if this is a fresh snapshot or if it was loaded from disk.
 	New bool `json:"-"`
 
+	// IgnoreContentHashes indicates if modified files are synced even if their
+	// content hash is unchanged. Hashes are still recorded in the snapshot.
+	IgnoreContentHashes bool `json:"-"`
+
+	// RemoteContentHashes are the content hashes of the files in the remote path
+	// that were recorded elsewhere. They take precedence over the hashes in the snapshot.
+	RemoteContentHashes map[string]string `json:"-"`
+
 	// version for snapshot schema. Only snapshots matching the latest snapshot
 	// schema version are used and older ones are invalidated (by deleting them)
 	Version string `json:"version"`
@@ -84,6 +93,9 @@ func newSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error) {
 		SnapshotPath: path,
 		New:          true,
 
+		IgnoreContentHashes: opts.IgnoreContentHashes,
+		RemoteContentHashes: opts.ContentHashes,
+
 		Version:    LatestSnapshotVersion,
 		Host:       opts.Host,
 		RemotePath: opts.RemotePath,
@@ -171,8 +183,24 @@ func (s *Snapshot) diff(ctx context.Context, all []fileset.File) (diff, error) {
 		return diff{}, fmt.Errorf("error parsing existing sync state. Please delete your existing sync snapshot file (%s) and retry: %w", s.SnapshotPath, err)
 	}
 
+	targetState.computeContentHashes(all, currentState)
+
 	// Compute diff to apply to get from current state to new target state.
-	diff := computeDiff(targetState, currentState)
+	// Without previous hashes, modified files are synced regardless of their content.
+	previousState := currentState
+	if len(s.RemoteContentHashes) > 0 {
+		withRemoteHashes := *currentState
+		withRemoteHashes.ContentHashes = make(map[string]string)
+		maps.Copy(withRemoteHashes.ContentHashes, currentState.ContentHashes)
+		maps.Copy(withRemoteHashes.ContentHashes, s.RemoteContentHashes)
+		previousState = &withRemoteHashes
+	}
+	if s.IgnoreContentHashes {
+		withoutHashes := *currentState
+		withoutHashes.ContentHashes = nil
+		previousState = &withoutHashes
+	}
+	diff := computeDiff(targetState, previousState)
 
 	// Update state to new value. This is not persisted to the file system before
 	// the diff is applied successfully.
@@ -1,7 +1,11 @@
 package sync
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -25,6 +29,11 @@ type SnapshotState struct {
 	// Inverse of LocalToRemoteNames. Together they form a 1:1 mapping where all
 	// the remote names and local names are unique.
 	RemoteToLocalNames map[string]string `json:"remote_to_local_names"`
+
+	// Map of local file names to the SHA256 hash of their content. Files found
+	// to have a newer mtime but the same content are not synced again.
+	// Snapshots written by older versions don't include this map.
+	ContentHashes map[string]string `json:"content_hashes,omitempty"`
 }
 
 // Convert an array of files on the local file system to a SnapshotState representation.
@@ -112,3 +121,44 @@ func (fs *SnapshotState) validate() error {
 	}
 	return nil
 }
+
+// computeContentHashes records the content hashes of the files in the state.
+// The hashes in the previous state are reused for files that were not modified
+// since, such that only modified files have to be read.
+func (fs *SnapshotState) computeContentHashes(localFiles []fileset.File, previous *SnapshotState) {
+	fs.ContentHashes = make(map[string]string)
+	for _, f := range localFiles {
+		modTime, ok := fs.LastModifiedTimes[f.Relative]
+		if !ok {
+			continue
+		}
+
+		prevModTime, ok := previous.LastModifiedTimes[f.Relative]
+		if prevHash, hashOk := previous.ContentHashes[f.Relative]; ok && hashOk && !modTime.After(prevModTime) {
+			fs.ContentHashes[f.Relative] = prevHash
+			continue
+		}
+
+		// Files that cannot be read are synced as if their content changed.
+		hash, err := contentHash(f.Absolute)
+		if err != nil {
+			continue
+		}
+		fs.ContentHashes[f.Relative] = hash
+	}
+}
+
+func contentHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
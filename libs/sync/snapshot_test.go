@@ -88,6 +88,141 @@ func TestDiff(t *testing.T) {
 	assert.Equal(t, map[string]string{"world.txt": "world.txt"}, state.RemoteToLocalNames)
 }
 
+func TestUnchangedContentDiff(t *testing.T) {
+	ctx := context.Background()
+
+	// Create temp project dir
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSet(projectDir)
+	require.NoError(t, err)
+	state := Snapshot{
+		SnapshotState: &SnapshotState{
+			LastModifiedTimes:  make(map[string]time.Time),
+			LocalToRemoteNames: make(map[string]string),
+			RemoteToLocalNames: make(map[string]string),
+		},
+	}
+
+	helloFilePath := filepath.Join(projectDir, "hello.txt")
+	f1 := testfile.CreateFile(t, helloFilePath)
+	defer f1.Close(t)
+	f1.Overwrite(t, "hello")
+
+	files, err := fileSet.All()
+	assert.NoError(t, err)
+	change, err := state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+	assertKeysOfMap(t, state.ContentHashes, []string{"hello.txt"})
+
+	// hello.txt is touched without changing its content
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(helloFilePath, future, future))
+	files, err = fileSet.All()
+	assert.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.Len(t, change.put, 0)
+
+	// hello.txt is edited
+	f1.Overwrite(t, "hello world")
+	future = future.Add(time.Hour)
+	require.NoError(t, os.Chtimes(helloFilePath, future, future))
+	files, err = fileSet.All()
+	assert.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+}
+
+func TestUnchangedContentDiffIgnoringContentHashes(t *testing.T) {
+	ctx := context.Background()
+
+	// Create temp project dir
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSet(projectDir)
+	require.NoError(t, err)
+	state := Snapshot{
+		SnapshotState: &SnapshotState{
+			LastModifiedTimes:  make(map[string]time.Time),
+			LocalToRemoteNames: make(map[string]string),
+			RemoteToLocalNames: make(map[string]string),
+		},
+	}
+
+	helloFilePath := filepath.Join(projectDir, "hello.txt")
+	f1 := testfile.CreateFile(t, helloFilePath)
+	defer f1.Close(t)
+	f1.Overwrite(t, "hello")
+	worldFilePath := filepath.Join(projectDir, "world.txt")
+	f2 := testfile.CreateFile(t, worldFilePath)
+	f2.Close(t)
+
+	// New files are put
+	files, err := fileSet.All()
+	assert.NoError(t, err)
+	change, err := state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"hello.txt", "world.txt"}, change.put)
+
+	// hello.txt is touched without changing its content and world.txt is removed
+	state.IgnoreContentHashes = true
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(helloFilePath, future, future))
+	require.NoError(t, os.Remove(worldFilePath))
+	files, err = fileSet.All()
+	assert.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+	assert.Equal(t, []string{"world.txt"}, change.delete)
+
+	// Hashes are still recorded
+	assertKeysOfMap(t, state.ContentHashes, []string{"hello.txt"})
+}
+
+func TestUnchangedContentDiffWithRemoteContentHashes(t *testing.T) {
+	ctx := context.Background()
+
+	// Create temp project dir
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSet(projectDir)
+	require.NoError(t, err)
+
+	helloFilePath := filepath.Join(projectDir, "hello.txt")
+	f1 := testfile.CreateFile(t, helloFilePath)
+	defer f1.Close(t)
+	f1.Overwrite(t, "hello")
+	worldFilePath := filepath.Join(projectDir, "world.txt")
+	f2 := testfile.CreateFile(t, worldFilePath)
+	defer f2.Close(t)
+	f2.Overwrite(t, "world")
+
+	// Record the hashes as a previous sync from another snapshot would.
+	helloHash, err := contentHash(helloFilePath)
+	require.NoError(t, err)
+
+	// The snapshot is empty, as it is on a fresh checkout.
+	state := Snapshot{
+		SnapshotState: &SnapshotState{
+			LastModifiedTimes:  make(map[string]time.Time),
+			LocalToRemoteNames: make(map[string]string),
+			RemoteToLocalNames: make(map[string]string),
+		},
+		RemoteContentHashes: map[string]string{
+			"hello.txt": helloHash,
+			"world.txt": "outdated",
+		},
+	}
+
+	files, err := fileSet.All()
+	assert.NoError(t, err)
+	change, err := state.diff(ctx, files)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"world.txt"}, change.put)
+	assertKeysOfMap(t, state.ContentHashes, []string{"hello.txt", "world.txt"})
+}
+
 func TestSymlinkDiff(t *testing.T) {
 	ctx := context.Background()
 
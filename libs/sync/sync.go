@@ -3,6 +3,7 @@ package sync
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/databricks/cli/libs/filer"
@@ -22,10 +23,22 @@ type SyncOptions struct {
 
 	Full bool
 
+	// Upload files that were modified since the previous sync, even if
+	// their content hash is unchanged.
+	IgnoreContentHashes bool
+
+	// Content hashes of the files in the remote path, as recorded by the previous
+	// sync (e.g. in a deployment state). They take precedence over the hashes in the
+	// local snapshot, such that unchanged files are not uploaded even without one.
+	ContentHashes map[string]string
+
 	SnapshotBasePath string
 
 	PollInterval time.Duration
 
+	// Maximum number of concurrent requests. Defaults to [MaxRequestsInFlight].
+	MaxConcurrency int
+
 	WorkspaceClient *databricks.WorkspaceClient
 
 	CurrentUser *iam.User
@@ -50,21 +63,7 @@ type Sync struct {
 
 // New initializes and returns a new [Sync] instance.
 func New(ctx context.Context, opts SyncOptions) (*Sync, error) {
-	fileSet, err := git.NewFileSet(opts.LocalPath)
-	if err != nil {
-		return nil, err
-	}
-	err = fileSet.EnsureValidGitIgnoreExists()
-	if err != nil {
-		return nil, err
-	}
-
-	includeFileSet, err := fileset.NewGlobSet(opts.LocalPath, opts.Include)
-	if err != nil {
-		return nil, err
-	}
-
-	excludeFileSet, err := fileset.NewGlobSet(opts.LocalPath, opts.Exclude)
+	s, err := newFileSets(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -102,19 +101,83 @@ func New(ctx context.Context, opts SyncOptions) (*Sync, error) {
 		return nil, err
 	}
 
+	s.SyncOptions = &opts
+	s.snapshot = snapshot
+	s.filer = filer
+	s.notifier = &NopNotifier{}
+	return s, nil
+}
+
+// newFileSets returns a [Sync] with the file sets for the specified options.
+func newFileSets(opts SyncOptions) (*Sync, error) {
+	fileSet, err := git.NewFileSet(opts.LocalPath)
+	if err != nil {
+		return nil, err
+	}
+	err = fileSet.EnsureValidGitIgnoreExists()
+	if err != nil {
+		return nil, err
+	}
+
+	includeFileSet, err := fileset.NewGlobSet(opts.LocalPath, opts.Include)
+	if err != nil {
+		return nil, err
+	}
+
+	excludeFileSet, err := fileset.NewGlobSet(opts.LocalPath, opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Sync{
 		SyncOptions: &opts,
 
 		fileSet:        fileSet,
 		includeFileSet: includeFileSet,
 		excludeFileSet: excludeFileSet,
-		snapshot:       snapshot,
-		filer:          filer,
-		notifier:       &NopNotifier{},
-		seq:            0,
 	}, nil
 }
 
+// Preview returns the files that a single synchronization with the specified
+// options would upload and delete. Unlike [New], it does not access the workspace
+// and it does not update the snapshot on disk.
+func Preview(ctx context.Context, opts SyncOptions) (put []string, delete []string, err error) {
+	if opts.WorkspaceClient != nil {
+		opts.Host = opts.WorkspaceClient.Config.Host
+	}
+	if opts.Host == "" {
+		return nil, nil, fmt.Errorf("failed to resolve host for snapshot")
+	}
+
+	s, err := newFileSets(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.Full {
+		s.snapshot, err = newSnapshot(ctx, s.SyncOptions)
+	} else {
+		s.snapshot, err = loadOrNewSnapshot(ctx, s.SyncOptions)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load sync snapshot: %w", err)
+	}
+
+	files, err := getFileList(ctx, s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	change, err := s.snapshot.diff(ctx, files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	slices.Sort(change.put)
+	slices.Sort(change.delete)
+	return change.put, change.delete, nil
+}
+
 func (s *Sync) Events() <-chan Event {
 	ch := make(chan Event, MaxRequestsInFlight)
 	s.notifier = &ChannelNotifier{ch}
@@ -224,6 +287,11 @@ func (s *Sync) SnapshotPath() string {
 	return s.snapshot.SnapshotPath
 }
 
+// ContentHashes returns the content hashes of the files as of the last sync.
+func (s *Sync) ContentHashes() map[string]string {
+	return s.snapshot.ContentHashes
+}
+
 func (s *Sync) RunContinuous(ctx context.Context) error {
 	ticker := time.NewTicker(s.PollInterval)
 	defer ticker.Stop()
@@ -8,6 +8,7 @@ import (
 
 	"github.com/databricks/cli/libs/fileset"
 	"github.com/databricks/cli/libs/git"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -162,3 +163,27 @@ func TestRecursiveExclude(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, len(fileList), 7)
 }
+
+func TestPreview(t *testing.T) {
+	ctx := context.Background()
+
+	dir := setupFiles(t)
+	opts := SyncOptions{
+		LocalPath:        dir,
+		RemotePath:       "/Workspace/Users/user@domain.com/files",
+		Host:             "https://example.com",
+		SnapshotBasePath: t.TempDir(),
+	}
+
+	put, del, err := Preview(ctx, opts)
+	require.NoError(t, err)
+	assert.Len(t, put, 9)
+	assert.Contains(t, put, "a.go")
+	assert.Contains(t, put, "test/sub1/sub2/g.go")
+	assert.Empty(t, del)
+
+	// The preview does not persist the snapshot.
+	s, err := loadOrNewSnapshot(ctx, &opts)
+	require.NoError(t, err)
+	assert.Empty(t, s.LastModifiedTimes)
+}
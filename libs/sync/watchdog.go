@@ -76,44 +76,50 @@ func (s *Sync) applyPut(ctx context.Context, localName string) error {
 	return nil
 }
 
-func groupRunSingle(ctx context.Context, group *errgroup.Group, fn func(context.Context, string) error, path string) {
-	// Return early if the context has already been cancelled.
-	select {
-	case <-ctx.Done():
-		return
-	default:
-		// Proceed.
+func (s *Sync) maxConcurrency() int {
+	if s.MaxConcurrency > 0 {
+		return s.MaxConcurrency
 	}
-
-	group.Go(func() error {
-		return fn(ctx, path)
-	})
+	return MaxRequestsInFlight
 }
 
-func groupRunParallel(ctx context.Context, paths []string, fn func(context.Context, string) error) error {
-	group, ctx := errgroup.WithContext(ctx)
-	group.SetLimit(MaxRequestsInFlight)
+// groupRunParallel runs fn for all paths with bounded concurrency. A failure for one
+// path does not stop the others; the errors for all failed paths are returned together.
+func (s *Sync) groupRunParallel(ctx context.Context, paths []string, fn func(context.Context, string) error) error {
+	var group errgroup.Group
+	group.SetLimit(s.maxConcurrency())
+
+	errs := make([]error, len(paths))
+	for i, path := range paths {
+		// Stop scheduling work if the context has been cancelled.
+		if ctx.Err() != nil {
+			errs[i] = ctx.Err()
+			break
+		}
 
-	for _, path := range paths {
-		groupRunSingle(ctx, group, fn, path)
+		i, path := i, path
+		group.Go(func() error {
+			errs[i] = fn(ctx, path)
+			return nil
+		})
 	}
 
-	// Wait for goroutines to finish and return first non-nil error return if any.
-	return group.Wait()
+	group.Wait()
+	return errors.Join(errs...)
 }
 
 func (s *Sync) applyDiff(ctx context.Context, d diff) error {
 	var err error
 
 	// Delete files in parallel.
-	err = groupRunParallel(ctx, d.delete, s.applyDelete)
+	err = s.groupRunParallel(ctx, d.delete, s.applyDelete)
 	if err != nil {
 		return err
 	}
 
 	// Delete directories ordered by depth from leaf to root.
 	for _, group := range d.groupedRmdir() {
-		err = groupRunParallel(ctx, group, s.applyRmdir)
+		err = s.groupRunParallel(ctx, group, s.applyRmdir)
 		if err != nil {
 			return err
 		}
@@ -121,14 +127,14 @@ func (s *Sync) applyDiff(ctx context.Context, d diff) error {
 
 	// Create directories (leafs only because intermediates are created automatically).
 	for _, group := range d.groupedMkdir() {
-		err = groupRunParallel(ctx, group, s.applyMkdir)
+		err = s.groupRunParallel(ctx, group, s.applyMkdir)
 		if err != nil {
 			return err
 		}
 	}
 
 	// Put files in parallel.
-	err = groupRunParallel(ctx, d.put, s.applyPut)
+	err = s.groupRunParallel(ctx, d.put, s.applyPut)
 
 	return err
 }
@@ -0,0 +1,61 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupRunParallelBoundsConcurrency(t *testing.T) {
+	s := &Sync{SyncOptions: &SyncOptions{MaxConcurrency: 2}}
+
+	var inFlight, maxInFlight, calls atomic.Int32
+	paths := []string{"a", "b", "c", "d", "e", "f"}
+	err := s.groupRunParallel(context.Background(), paths, func(ctx context.Context, path string) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		calls.Add(1)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(len(paths)), calls.Load())
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestGroupRunParallelAggregatesErrors(t *testing.T) {
+	s := &Sync{SyncOptions: &SyncOptions{}}
+
+	var calls atomic.Int32
+	err := s.groupRunParallel(context.Background(), []string{"a", "b", "c"}, func(ctx context.Context, path string) error {
+		calls.Add(1)
+		if path == "b" {
+			return nil
+		}
+		return fmt.Errorf("failed to upload %s", path)
+	})
+	assert.EqualError(t, err, "failed to upload a\nfailed to upload c")
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestGroupRunParallelStopsWhenCancelled(t *testing.T) {
+	s := &Sync{SyncOptions: &SyncOptions{}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := s.groupRunParallel(ctx, []string{"a"}, func(ctx context.Context, path string) error {
+		t.Fatal("unexpected call")
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -18,3 +18,40 @@ func replaceNonAlphanumeric(r rune) rune {
 	}
 	return '_'
 }
+
+// ClosestMatch returns the candidate that is most similar to s, for use in
+// "did you mean" suggestions. It returns an empty string if no candidate is
+// similar enough to be a plausible misspelling of s.
+func ClosestMatch(s string, candidates []string) string {
+	best := ""
+	bestDistance := len(s)/3 + 1
+	for _, c := range candidates {
+		d := levenshtein(strings.ToLower(s), strings.ToLower(c))
+		if d < bestDistance || (d == bestDistance && best != "" && c < best) {
+			best, bestDistance = c, d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
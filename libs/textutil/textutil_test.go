@@ -52,3 +52,20 @@ func TestNormalizeString(t *testing.T) {
 		assert.Equal(t, c.expected, NormalizeString(c.input))
 	}
 }
+
+func TestClosestMatch(t *testing.T) {
+	candidates := []string{"bundle", "resources", "targets", "workspace"}
+
+	assert.Equal(t, "resources", ClosestMatch("resource", candidates))
+	assert.Equal(t, "targets", ClosestMatch("Target", candidates))
+	assert.Equal(t, "workspace", ClosestMatch("wrokspace", candidates))
+	assert.Equal(t, "", ClosestMatch("permissions", candidates))
+	assert.Equal(t, "", ClosestMatch("foo", nil))
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, levenshtein("abc", "abc"))
+	assert.Equal(t, 1, levenshtein("abc", "abcd"))
+	assert.Equal(t, 2, levenshtein("abc", "bca"))
+	assert.Equal(t, 3, levenshtein("", "abc"))
+}